@@ -0,0 +1,109 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sync/atomic"
+)
+
+var (
+	ErrNoVariants      = errors.New("assigner needs at least one variant")
+	ErrVariantWeight   = errors.New("variant weights must not be negative and must not all be zero")
+	ErrVariantConflict = errors.New("variant names must be unique")
+)
+
+// named arm of an experiment, receiving a share of Weight / sum of all weights of the users
+type Variant struct {
+	Name   string `json:"name"`
+	Weight int    `json:"weight"`
+}
+
+// assigns users to the variants of an experiment. Assignments depend only on the salt, the variants and the
+// user ID, so they are stable across processes and restarts and can be recomputed for audits; changing the
+// salt reshuffles all users. Assigner is safe for concurrent use.
+type Assigner struct {
+	salt     string
+	variants []Variant
+	total    uint64
+	counts   []atomic.Uint64
+}
+
+// allocation of an experiment's users to its variants as recorded by an Assigner
+type AllocationReport struct {
+	Salt     string              `json:"salt"`
+	Total    uint64              `json:"total"`
+	Variants []VariantAllocation `json:"variants"`
+}
+
+// allocation of users to a single variant
+type VariantAllocation struct {
+	Variant
+	// share of users the variant should receive according to its weight, 0-1
+	Expected float64 `json:"expected"`
+	// assignments made so far and their share of all assignments, 0-1
+	Assigned uint64  `json:"assigned"`
+	Actual   float64 `json:"actual"`
+}
+
+// returns Assigner for the experiment identified by salt, splitting users between variants by weight
+func NewAssigner(salt string, variants ...Variant) (*Assigner, error) {
+	if len(variants) == 0 {
+		return nil, ErrNoVariants
+	}
+	var total uint64
+	names := make(map[string]bool, len(variants))
+	for _, v := range variants {
+		if v.Weight < 0 {
+			return nil, ErrVariantWeight
+		}
+		if names[v.Name] {
+			return nil, fmt.Errorf("%w: %q", ErrVariantConflict, v.Name)
+		}
+		names[v.Name] = true
+		total += uint64(v.Weight)
+	}
+	if total == 0 {
+		return nil, ErrVariantWeight
+	}
+	return &Assigner{
+		salt:     salt,
+		variants: append([]Variant(nil), variants...),
+		total:    total,
+		counts:   make([]atomic.Uint64, len(variants)),
+	}, nil
+}
+
+// returns the variant userID is assigned to and counts the assignment for Report
+func (a *Assigner) Assign(userID string) Variant {
+	// the NUL keeps salt "a" with user "bc" apart from salt "ab" with user "c"
+	point, _ := bits.Mul64(keyHash("random.Assigner:", a.salt+"\x00"+userID), a.total)
+	for i, v := range a.variants {
+		if point < uint64(v.Weight) {
+			a.counts[i].Add(1)
+			return v
+		}
+		point -= uint64(v.Weight)
+	}
+	panic("unreachable")
+}
+
+// returns the allocation of all assignments made so far, e.g. for exporting as JSON or checking for
+// sample ratio mismatch
+func (a *Assigner) Report() AllocationReport {
+	report := AllocationReport{Salt: a.salt, Variants: make([]VariantAllocation, len(a.variants))}
+	for i, v := range a.variants {
+		report.Variants[i] = VariantAllocation{
+			Variant:  v,
+			Expected: float64(v.Weight) / float64(a.total),
+			Assigned: a.counts[i].Load(),
+		}
+		report.Total += report.Variants[i].Assigned
+	}
+	if report.Total > 0 {
+		for i := range report.Variants {
+			report.Variants[i].Actual = float64(report.Variants[i].Assigned) / float64(report.Total)
+		}
+	}
+	return report
+}
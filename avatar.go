@@ -0,0 +1,17 @@
+package random
+
+import "crypto/sha256"
+
+// returns stable, well distributed seed for identicon or avatar generation derived from key,
+// e.g. a username. Equal keys always give equal seeds.
+func AvatarSeed(key string) [32]byte {
+	// the prefix keeps seeds from matching plain sha256 hashes of the same key used elsewhere
+	return sha256.Sum256([]byte("random.AvatarSeed:" + key))
+}
+
+// returns pseudo-random seed for identicon or avatar generation, see AvatarSeed for a stable one
+func (r *randomizer) AvatarSeed() [32]byte {
+	var seed [32]byte
+	copy(seed[:], r.Bytes(len(seed)))
+	return seed
+}
@@ -0,0 +1,122 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// how BackoffSchedule randomizes the delays of an exponential backoff
+type JitterStrategy int
+
+const (
+	// delays are base * 2^attempt capped at cap, without randomization
+	JitterNone JitterStrategy = iota
+	// delays are drawn from [0, d] where d is the capped exponential delay
+	JitterFull
+	// delays are drawn from [d/2, d] where d is the capped exponential delay
+	JitterEqual
+	// delays are drawn from [base, 3 * previous delay] and capped at cap
+	JitterDecorrelated
+)
+
+func (s JitterStrategy) String() string {
+	switch s {
+	case JitterNone:
+		return "JitterNone"
+	case JitterFull:
+		return "JitterFull"
+	case JitterEqual:
+		return "JitterEqual"
+	case JitterDecorrelated:
+		return "JitterDecorrelated"
+	}
+	return fmt.Sprintf("JitterStrategy(%d)", int(s))
+}
+
+// returns the delays before each of attempts retries of an exponential backoff starting at base and capped at cap,
+// randomized according to strategy. The strategies follow the "Exponential Backoff And Jitter" AWS architecture
+// blog post. It panics if base < 0, cap < base or strategy is unknown.
+func (r *randomizer) BackoffSchedule(attempts int, base time.Duration, cap time.Duration, strategy JitterStrategy) []time.Duration {
+	if base < 0 || cap < base {
+		panic("invalid argument to BackoffSchedule: need 0 <= base <= cap")
+	}
+	if attempts <= 0 {
+		return nil
+	}
+	schedule := make([]time.Duration, attempts)
+	prev := base
+	for i := range schedule {
+		d := exponentialDelay(base, cap, i)
+		switch strategy {
+		case JitterNone:
+		case JitterFull:
+			d = r.FullJitter(base, cap, i)
+		case JitterEqual:
+			d = r.EqualJitter(base, cap, i)
+		case JitterDecorrelated:
+			upper := cap
+			if prev <= cap/3 {
+				upper = prev * 3
+			}
+			d = r.Duration(base, upper)
+			prev = d
+		default:
+			panic(fmt.Sprintf("invalid argument to BackoffSchedule: unknown strategy %s", strategy))
+		}
+		schedule[i] = d
+	}
+	return schedule
+}
+
+// returns d randomized by up to fraction of it in either direction, i.e. a duration in [d-fraction*d, d+fraction*d],
+// to spread out timers that would otherwise fire together. It panics if d < 0 or fraction is not in [0,1].
+func (r *randomizer) Jitter(d time.Duration, fraction float64) time.Duration {
+	if d < 0 || !(fraction >= 0 && fraction <= 1) {
+		panic("invalid argument to Jitter: need d >= 0 and fraction in [0,1]")
+	}
+	// converting a float64 of 2^63 or more is undefined, and float64(d) may round up to it
+	delta := d
+	if f := float64(d) * fraction; f < float64(d) {
+		delta = time.Duration(f)
+	}
+	upper := d + delta
+	if upper < d {
+		upper = math.MaxInt64
+	}
+	return r.Duration(d-delta, upper)
+}
+
+// returns the delay before retry number attempt, counted from 0, of an exponential backoff starting at base and
+// capped at cap with "full jitter": drawn from [0, d] where d is the capped exponential delay. It panics if
+// base < 0 or cap < base.
+func (r *randomizer) FullJitter(base time.Duration, cap time.Duration, attempt int) time.Duration {
+	if base < 0 || cap < base {
+		panic("invalid argument to FullJitter: need 0 <= base <= cap")
+	}
+	return r.Duration(0, exponentialDelay(base, cap, attempt))
+}
+
+// same as FullJitter but with "equal jitter": the delay is drawn from [d/2, d]
+func (r *randomizer) EqualJitter(base time.Duration, cap time.Duration, attempt int) time.Duration {
+	if base < 0 || cap < base {
+		panic("invalid argument to EqualJitter: need 0 <= base <= cap")
+	}
+	d := exponentialDelay(base, cap, attempt)
+	return d - d/2 + r.Duration(0, d/2)
+}
+
+// returns base * 2^attempt, or cap if that is larger
+func exponentialDelay(base time.Duration, cap time.Duration, attempt int) time.Duration {
+	d := base
+	for i := 0; i < attempt && d < cap; i++ {
+		if d > cap/2 {
+			return cap
+		}
+		d *= 2
+	}
+	if d > cap {
+		return cap
+	}
+	return d
+}
@@ -0,0 +1,111 @@
+package random
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+// bitcoin base58 alphabet, 0-9 a-z A-Z without 0, O, I and l
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	ErrBase58Character = errors.New("invalid base58 character")
+	ErrBase58Checksum  = errors.New("base58check checksum mismatch")
+	ErrBase58Short     = errors.New("base58check string too short")
+)
+
+var base58Index = func() [256]int {
+	var idx [256]int
+	for i := range idx {
+		idx[i] = -1
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		idx[base58Alphabet[i]] = i
+	}
+	return idx
+}()
+
+// returns identifier of base58 characters carrying at least the entropy of nBytes random bytes, e.g. 22
+// characters for 16 bytes. Characters are drawn uniformly from the alphabet instead of encoding the bytes,
+// so every identifier of a given nBytes has the same length.
+func (r *randomizer) Base58(nBytes int) string {
+	return r.StringFromPool(LengthForEntropy(len(base58Alphabet), float64(8*nBytes)), base58Alphabet)
+}
+
+// returns Base58Check encoding of version followed by payloadLen pseudo-random bytes and a 4 byte
+// double-sha256 checksum, the format of bitcoin addresses. DecodeBase58Check detects mistyped values.
+func (r *randomizer) Base58Check(version byte, payloadLen int) string {
+	data := make([]byte, 0, 1+payloadLen+4)
+	data = append(data, version)
+	data = append(data, r.Bytes(payloadLen)...)
+	return base58Encode(append(data, base58Checksum(data)...))
+}
+
+// returns version and payload of a Base58Check encoded string, or an error if it is malformed
+// or its checksum does not match
+func DecodeBase58Check(s string) (version byte, payload []byte, err error) {
+	data, err := base58Decode(s)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(data) < 5 {
+		return 0, nil, ErrBase58Short
+	}
+	body, checksum := data[:len(data)-4], data[len(data)-4:]
+	if !bytes.Equal(base58Checksum(body), checksum) {
+		return 0, nil, ErrBase58Checksum
+	}
+	return body[0], body[1:], nil
+}
+
+// returns first 4 bytes of sha256(sha256(b))
+func base58Checksum(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
+
+func base58Encode(b []byte) string {
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	out := make([]byte, 0, len(b)*138/100+1)
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	// every leading zero byte is encoded as the first alphabet character
+	for i := 0; i < zeros; i++ {
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+func base58Decode(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for i := 0; i < len(s); i++ {
+		v := base58Index[s[i]]
+		if v < 0 {
+			return nil, ErrBase58Character
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(v)))
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
@@ -0,0 +1,83 @@
+package random
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// test vectors of bitcoin core, src/test/data/base58_encode_decode.json
+func TestBase58Encode(t *testing.T) {
+	for _, tc := range []struct {
+		hex  string
+		want string
+	}{
+		{"", ""},
+		{"61", "2g"},
+		{"626262", "a3gV"},
+		{"636363", "aPEr"},
+		{"73696d706c792061206c6f6e6720737472696e67", "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+		{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+		{"516b6fcd0f", "ABnLTmg"},
+		{"bf4f89001e670274dd", "3SEo3LWLoPntC"},
+		{"572e4794", "3EFU7m"},
+		{"ecac89cad93923c02321", "EJDM8drfXA6uyA"},
+		{"10c8511e", "Rt5zm"},
+		{"00000000000000000000", "1111111111"},
+	} {
+		b, err := hex.DecodeString(tc.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := base58Encode(b); got != tc.want {
+			t.Errorf("base58Encode(%s) = %q, want %q", tc.hex, got, tc.want)
+		}
+		got, err := base58Decode(tc.want)
+		if err != nil || !bytes.Equal(got, b) {
+			t.Errorf("base58Decode(%q) = %x, %v, want %s", tc.want, got, err, tc.hex)
+		}
+	}
+}
+
+func TestBase58Check(t *testing.T) {
+	// the address of the bitcoin genesis block
+	payload, _ := hex.DecodeString("62e907b15cbf27d5425399ebf6f0fb50ebb88f18")
+	const address = "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"
+	r := NewSFRand(WithEntropySource(bytes.NewReader(payload)), WithFallbackDisabled())
+	if got := r.Base58Check(0, len(payload)); got != address {
+		t.Errorf("Base58Check = %q, want %q", got, address)
+	}
+
+	version, got, err := DecodeBase58Check(address)
+	if err != nil || version != 0 || !bytes.Equal(got, payload) {
+		t.Errorf("DecodeBase58Check(%q) = %d, %x, %v, want 0, %x", address, version, got, err, payload)
+	}
+
+	r = NewSFRand()
+	for i := 0; i < 100; i++ {
+		s := r.Base58Check(byte(i), i%40)
+		version, got, err := DecodeBase58Check(s)
+		if err != nil || version != byte(i) || len(got) != i%40 {
+			t.Fatalf("DecodeBase58Check(%q) = %d, %x, %v", s, version, got, err)
+		}
+	}
+}
+
+func TestDecodeBase58CheckErrors(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want error
+	}{
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb", ErrBase58Checksum},
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfaN", ErrBase58Checksum},
+		{"1B1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", ErrBase58Checksum},
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfN0", ErrBase58Character},
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNl", ErrBase58Character},
+		{"2g", ErrBase58Short},
+	} {
+		if _, _, err := DecodeBase58Check(tc.s); !errors.Is(err, tc.want) {
+			t.Errorf("DecodeBase58Check(%q) = %v, want %v", tc.s, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package random
+
+import "encoding/binary"
+
+// returns count pseudo-random ints between min and max, inclusive, drawing the entropy for all of them in a
+// single read. It panics if max < min.
+func (r *randomizer) Ints(count int, min int, max int) []int {
+	if max < min {
+		panic("invalid argument to Ints: max < min")
+	}
+	n := uint64(max) - uint64(min) + 1
+	// values below 2^64 mod n are redrawn individually, which is rare, so that every value is equally likely.
+	// n only wraps to 0 for all 64 bit ints, where every value is accepted.
+	var limit uint64
+	if n != 0 {
+		limit = -n % n
+	}
+	b := r.Bytes(8 * count)
+	out := make([]int, count)
+	for i := range out {
+		v := binary.LittleEndian.Uint64(b[8*i:])
+		switch {
+		case n == 0:
+			out[i] = int(v)
+		case v < limit:
+			out[i] = r.Int(min, max)
+		default:
+			out[i] = min + int(v%n)
+		}
+	}
+	return out
+}
+
+// returns count strings of length pseudo-random runes from pool, drawing the entropy for all of them in a
+// single read
+func (r *randomizer) Strings(count int, length int, pool []rune) []string {
+	out := make([]string, count)
+	if count*length == 0 {
+		return out
+	}
+	idx := r.Ints(count*length, 0, len(pool)-1)
+	s := make([]rune, length)
+	for i := range out {
+		for j := range s {
+			s[j] = pool[idx[i*length+j]]
+		}
+		out[i] = string(s)
+	}
+	return out
+}
+
+// returns count pseudo-random bools, drawing one bit for each from a single read
+func (r *randomizer) BoolSlice(count int) []bool {
+	b := r.Bytes((count + 7) / 8)
+	out := make([]bool, count)
+	for i := range out {
+		out[i] = b[i/8]>>(i%8)&1 == 1
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package random
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// returns bucket in [0, buckets) for key, e.g. for sticky A/B assignment. Equal keys always land in the same
+// bucket while distinct keys are spread uniformly. Include the experiment name in key, e.g. "checkout:" + userID,
+// to keep assignments of different experiments independent. It panics if buckets < 1.
+func Bucket(key string, buckets int) int {
+	if buckets < 1 {
+		panic("Bucket: buckets must be at least 1")
+	}
+	// the high word of hash*buckets maps the hash onto the buckets without modulo bias worth mentioning
+	hi, _ := bits.Mul64(keyHash("random.Bucket:", key), uint64(buckets))
+	return int(hi)
+}
+
+// reports whether key falls into a rollout covering percent (0-100) of all keys. The answer is stable per key
+// and keys included at some percentage stay included when the percentage is raised.
+func InRollout(key string, percent float64) bool {
+	if percent <= 0 {
+		return false
+	}
+	if percent >= 100 {
+		return true
+	}
+	u := float64(keyHash("random.InRollout:", key)>>11) / (1 << 53)
+	return u*100 < percent
+}
+
+// returns uniformly distributed 64 bit hash of key, prefixed to separate hashes of different uses
+func keyHash(prefix, key string) uint64 {
+	sum := sha256.Sum256([]byte(prefix + key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
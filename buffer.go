@@ -0,0 +1,105 @@
+package random
+
+import (
+	"encoding/binary"
+	"io"
+	"sync"
+)
+
+// bytes read ahead from crypto/rand by the default entropy source
+const entropyBufferSize = 4096
+
+// serves reads of an entropy source from blocks read ahead, so that the many short reads of Int, Rune or UUID
+// do not each cost a read of the source. Served bytes are zeroed in the buffer. It is safe for concurrent use.
+type bufferedSource struct {
+	mtx sync.Mutex
+	src io.Reader
+	buf []byte
+	// unread bytes are buf[off:]
+	off int
+}
+
+func newBufferedSource(src io.Reader, size int) *bufferedSource {
+	return &bufferedSource{src: src, buf: make([]byte, size), off: size}
+}
+
+func (b *bufferedSource) Read(p []byte) (int, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	n := 0
+	for n < len(p) {
+		if b.off == len(b.buf) {
+			// reads of at least a whole block gain nothing from the buffer
+			if len(p)-n >= len(b.buf) {
+				m, err := io.ReadFull(b.src, p[n:])
+				return n + m, err
+			}
+			if err := b.refill(); err != nil {
+				return n, err
+			}
+		}
+		c := copy(p[n:], b.buf[b.off:])
+		clear(b.buf[b.off : b.off+c])
+		b.off += c
+		n += c
+	}
+	return n, nil
+}
+
+// implemented by the internal sources to return the next 8 bytes as uint64, without the allocation of passing
+// a slice through io.Reader
+type uint64Reader interface {
+	uint64() (uint64, error)
+}
+
+func (b *bufferedSource) uint64() (uint64, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if len(b.buf)-b.off < 8 {
+		if err := b.refill(); err != nil {
+			return 0, err
+		}
+	}
+	v := binary.LittleEndian.Uint64(b.buf[b.off:])
+	clear(b.buf[b.off : b.off+8])
+	b.off += 8
+	return v, nil
+}
+
+// replaces the buffer with a fresh block, the caller must hold mtx. Remaining bytes are discarded.
+func (b *bufferedSource) refill() error {
+	if _, err := io.ReadFull(b.src, b.buf); err != nil {
+		clear(b.buf)
+		b.off = len(b.buf)
+		return err
+	}
+	b.off = 0
+	return nil
+}
+
+// spreads reads over bufferedSources of the same entropy source kept in a sync.Pool, which in the common case
+// holds one per P, so that parallel callers do not contend on the mutex of a single buffer
+type shardedSource struct {
+	shards sync.Pool
+}
+
+// returns shardedSource whose shards buffer a reader from newSrc each
+func newShardedSource(newSrc func() io.Reader, size int) *shardedSource {
+	s := &shardedSource{}
+	s.shards.New = func() any {
+		return newBufferedSource(newSrc(), size)
+	}
+	return s
+}
+
+func (s *shardedSource) Read(p []byte) (int, error) {
+	b := s.shards.Get().(*bufferedSource)
+	defer s.shards.Put(b)
+	return b.Read(p)
+}
+
+func (s *shardedSource) uint64() (uint64, error) {
+	b := s.shards.Get().(*bufferedSource)
+	defer s.shards.Put(b)
+	return b.uint64()
+}
@@ -0,0 +1,134 @@
+package random
+
+import (
+	"encoding/binary"
+	"io"
+	"math/bits"
+)
+
+const (
+	// keystream bytes generated with one key before it is replaced
+	chachaChunk = 64 * 1024
+	// keystream bytes generated before fresh bytes of the seed are mixed into the key
+	chachaReseedBytes = 1 << 20
+)
+
+// ChaCha20 DRBG seeded from seed, e.g. crypto/rand. The first keystream block of every chunk of output becomes
+// the key of the next one and is never handed out, so a later compromise of the state does not reveal earlier
+// output. Fresh seed bytes are mixed into the key every chachaReseedBytes. If reseeding fails after the initial
+// seed, the current key keeps being used and reseeding is retried on the next chunk. It is not safe for
+// concurrent use, wrap it in a bufferedSource.
+type chachaDRBG struct {
+	seed        io.Reader
+	key         [8]uint32
+	seeded      bool
+	sinceReseed int
+}
+
+func newChaChaDRBG(seed io.Reader) *chachaDRBG {
+	return &chachaDRBG{seed: seed}
+}
+
+func (c *chachaDRBG) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if err := c.reseed(); err != nil {
+			return n, err
+		}
+		m := min(len(p)-n, chachaChunk)
+		c.keystream(p[n : n+m])
+		n += m
+		c.sinceReseed += m
+	}
+	return n, nil
+}
+
+// mixes 32 bytes of seed into the key when it is due
+func (c *chachaDRBG) reseed() error {
+	if c.seeded && c.sinceReseed < chachaReseedBytes {
+		return nil
+	}
+	var b [32]byte
+	defer clear(b[:])
+	if _, err := io.ReadFull(c.seed, b[:]); err != nil {
+		if c.seeded {
+			return nil
+		}
+		return err
+	}
+	for i := range c.key {
+		c.key[i] ^= binary.LittleEndian.Uint32(b[4*i:])
+	}
+	c.seeded = true
+	c.sinceReseed = 0
+	return nil
+}
+
+// fills p, at most chachaChunk bytes, with keystream of the current key and replaces the key
+func (c *chachaDRBG) keystream(p []byte) {
+	var block [64]byte
+	defer clear(block[:])
+	chachaBlock(&block, &c.key, 0, [3]uint32{})
+	var next [8]uint32
+	for i := range next {
+		next[i] = binary.LittleEndian.Uint32(block[4*i:])
+	}
+	counter := uint32(1)
+	for ; len(p) >= 64; counter++ {
+		chachaBlock((*[64]byte)(p), &c.key, counter, [3]uint32{})
+		p = p[64:]
+	}
+	if len(p) > 0 {
+		chachaBlock(&block, &c.key, counter, [3]uint32{})
+		copy(p, block[:])
+	}
+	c.key = next
+}
+
+// writes the ChaCha20 block of key, counter and nonce to out, see RFC 8439. The DRBG always uses an all-zero
+// nonce. The state is kept in locals rather than an array so that the compiler keeps it in registers.
+func chachaBlock(out *[64]byte, key *[8]uint32, counter uint32, nonce [3]uint32) {
+	const c0, c1, c2, c3 = 0x61707865, 0x3320646e, 0x79622d32, 0x6b206574
+	x0, x1, x2, x3 := uint32(c0), uint32(c1), uint32(c2), uint32(c3)
+	x4, x5, x6, x7 := key[0], key[1], key[2], key[3]
+	x8, x9, x10, x11 := key[4], key[5], key[6], key[7]
+	x12, x13, x14, x15 := counter, nonce[0], nonce[1], nonce[2]
+	for i := 0; i < 10; i++ {
+		x0, x4, x8, x12 = quarterRound(x0, x4, x8, x12)
+		x1, x5, x9, x13 = quarterRound(x1, x5, x9, x13)
+		x2, x6, x10, x14 = quarterRound(x2, x6, x10, x14)
+		x3, x7, x11, x15 = quarterRound(x3, x7, x11, x15)
+		x0, x5, x10, x15 = quarterRound(x0, x5, x10, x15)
+		x1, x6, x11, x12 = quarterRound(x1, x6, x11, x12)
+		x2, x7, x8, x13 = quarterRound(x2, x7, x8, x13)
+		x3, x4, x9, x14 = quarterRound(x3, x4, x9, x14)
+	}
+	binary.LittleEndian.PutUint32(out[0:], x0+c0)
+	binary.LittleEndian.PutUint32(out[4:], x1+c1)
+	binary.LittleEndian.PutUint32(out[8:], x2+c2)
+	binary.LittleEndian.PutUint32(out[12:], x3+c3)
+	binary.LittleEndian.PutUint32(out[16:], x4+key[0])
+	binary.LittleEndian.PutUint32(out[20:], x5+key[1])
+	binary.LittleEndian.PutUint32(out[24:], x6+key[2])
+	binary.LittleEndian.PutUint32(out[28:], x7+key[3])
+	binary.LittleEndian.PutUint32(out[32:], x8+key[4])
+	binary.LittleEndian.PutUint32(out[36:], x9+key[5])
+	binary.LittleEndian.PutUint32(out[40:], x10+key[6])
+	binary.LittleEndian.PutUint32(out[44:], x11+key[7])
+	binary.LittleEndian.PutUint32(out[48:], x12+counter)
+	binary.LittleEndian.PutUint32(out[52:], x13+nonce[0])
+	binary.LittleEndian.PutUint32(out[56:], x14+nonce[1])
+	binary.LittleEndian.PutUint32(out[60:], x15+nonce[2])
+}
+
+func quarterRound(a, b, c, d uint32) (uint32, uint32, uint32, uint32) {
+	a += b
+	d = bits.RotateLeft32(d^a, 16)
+	c += d
+	b = bits.RotateLeft32(b^c, 12)
+	a += b
+	d = bits.RotateLeft32(d^a, 8)
+	c += d
+	b = bits.RotateLeft32(b^c, 7)
+	return a, b, c, d
+}
@@ -0,0 +1,107 @@
+package random
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+// RFC 8439 section 2.3.2
+func TestChaChaBlock(t *testing.T) {
+	var key [8]uint32
+	for i := range key {
+		b := byte(4 * i)
+		key[i] = uint32(b) | uint32(b+1)<<8 | uint32(b+2)<<16 | uint32(b+3)<<24
+	}
+	nonce := [3]uint32{0x09000000, 0x4a000000, 0x00000000}
+	want := "10f1e7e4d13b5915500fdd1fa32071c4c7d1f4c733c068030422aa9ac3d46c4e" +
+		"d2826446079faa0914c2d705d98b02a2b5129cd1de164eb9cbd083e8a2503c4e"
+
+	var out [64]byte
+	chachaBlock(&out, &key, 1, nonce)
+	if got := hex.EncodeToString(out[:]); got != want {
+		t.Errorf("chachaBlock = %s, want %s", got, want)
+	}
+}
+
+func TestChaChaDRBGRekey(t *testing.T) {
+	c := newChaChaDRBG(bytes.NewReader(bytes.Repeat([]byte{1}, 32)))
+	first := make([]byte, 64)
+	if _, err := c.Read(first); err != nil {
+		t.Fatal(err)
+	}
+	key := c.key
+	second := make([]byte, 64)
+	if _, err := c.Read(second); err != nil {
+		t.Fatal(err)
+	}
+	if c.key == key {
+		t.Error("key was not replaced after Read")
+	}
+	if bytes.Equal(first, second) {
+		t.Error("consecutive reads returned the same output")
+	}
+}
+
+// counts the reads of an endless source of seed bytes
+type countingReader struct {
+	reads int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	c.reads++
+	for i := range p {
+		p[i] = byte(c.reads + i)
+	}
+	return len(p), nil
+}
+
+func TestChaChaDRBGReseed(t *testing.T) {
+	seed := &countingReader{}
+	c := newChaChaDRBG(seed)
+	for _, tc := range []struct {
+		n     int
+		reads int
+	}{
+		{chachaReseedBytes - 1, 1},
+		{1, 1},
+		{1, 2},
+		{chachaReseedBytes - 1, 2},
+		{1, 3},
+	} {
+		if _, err := c.Read(make([]byte, tc.n)); err != nil {
+			t.Fatal(err)
+		}
+		if seed.reads != tc.reads {
+			t.Fatalf("seed read %d times after reading %d bytes, want %d", seed.reads, tc.n, tc.reads)
+		}
+	}
+}
+
+func TestChaChaDRBGReseedFailure(t *testing.T) {
+	seed := io.MultiReader(bytes.NewReader(make([]byte, 32)), iotest.ErrReader(errors.New("seed exhausted")))
+	c := newChaChaDRBG(seed)
+	if _, err := c.Read(make([]byte, chachaReseedBytes)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Read(make([]byte, 64)); err != nil {
+		t.Errorf("Read after failed reseed = %v, want current key to be kept", err)
+	}
+}
+
+func TestWithChaCha20FallbackDisabled(t *testing.T) {
+	r := NewSFRand(
+		WithChaCha20(),
+		WithEntropySource(iotest.ErrReader(errors.New("no entropy"))),
+		WithFallbackDisabled(),
+	)
+	if _, err := r.IntE(0, 100); !errors.Is(err, ErrEntropyUnavailable) {
+		t.Errorf("IntE = %v, want ErrEntropyUnavailable", err)
+	}
+	if _, err := r.BytesE(32); !errors.Is(err, ErrEntropyUnavailable) {
+		t.Errorf("BytesE = %v, want ErrEntropyUnavailable", err)
+	}
+}
@@ -0,0 +1,61 @@
+package random
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrNoItems       = errors.New("no items to choose from")
+	ErrWeightsLength = errors.New("number of weights does not match number of items")
+	ErrInvalidWeight = errors.New("weights must be finite and not negative, and at least one must be positive")
+)
+
+// returns element of items picked with probability proportional to its weight in weights, e.g. for loot
+// tables or traffic routing. Items with a weight of 0 are never picked.
+func WeightedChoice[T any](r SFRand, items []T, weights []float64) (T, error) {
+	var zero T
+	if len(items) == 0 {
+		return zero, ErrNoItems
+	}
+	if len(weights) != len(items) {
+		return zero, ErrWeightsLength
+	}
+	total := 0.0
+	last := -1
+	for i, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 0) {
+			return zero, ErrInvalidWeight
+		}
+		if w > 0 {
+			last = i
+		}
+		total += w
+	}
+	if last < 0 || math.IsInf(total, 0) {
+		return zero, ErrInvalidWeight
+	}
+
+	point := r.Float64() * total
+	for i, w := range weights {
+		if point < w {
+			return items[i], nil
+		}
+		point -= w
+	}
+	// rounding can leave point slightly above the last weight
+	return items[last], nil
+}
+
+// returns pseudo-random element of items. It panics if items is empty.
+func Choice[T any](r SFRand, items []T) T {
+	return items[r.PickIndex(len(items))]
+}
+
+// returns pseudo-random index into a collection of n elements, i.e. an int in [0,n). It panics if n < 1.
+func (r *randomizer) PickIndex(n int) int {
+	if n < 1 {
+		panic("invalid argument to PickIndex: n must be at least 1")
+	}
+	return r.Int(0, n-1)
+}
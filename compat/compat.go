@@ -0,0 +1,84 @@
+package compat
+
+import (
+	mathrand "math/rand"
+
+	"github.com/h4ckitt/random"
+)
+
+// Types and constructors for explicitly seeded generators are passed through to math/rand unchanged,
+// so code that builds its own *rand.Rand keeps compiling after switching imports.
+// Such generators are deterministic and NOT backed by the secure default instance.
+type (
+	Rand     = mathrand.Rand
+	Source   = mathrand.Source
+	Source64 = mathrand.Source64
+	Zipf     = mathrand.Zipf
+)
+
+var (
+	New       = mathrand.New
+	NewSource = mathrand.NewSource
+	NewZipf   = mathrand.NewZipf
+)
+
+// the secure default instance backing every package-level function
+var def = random.NewSFRand()
+
+// math/rand.Rand only keeps state for Read, which is served by def directly,
+// so sharing it between goroutines is safe as long as its source is
+var rnd = mathrand.New(def.AsSource())
+
+// no-op, kept so code calling rand.Seed keeps compiling. The default instance cannot be seeded
+// and therefore never produces a reproducible sequence.
+func Seed(int64) {}
+
+// returns a non-negative pseudo-random 63-bit integer as an int64
+func Int63() int64 { return rnd.Int63() }
+
+// returns a pseudo-random 32-bit value as a uint32
+func Uint32() uint32 { return rnd.Uint32() }
+
+// returns a pseudo-random 64-bit value as a uint64
+func Uint64() uint64 { return rnd.Uint64() }
+
+// returns a non-negative pseudo-random 31-bit integer as an int32
+func Int31() int32 { return rnd.Int31() }
+
+// returns a non-negative pseudo-random int
+func Int() int { return rnd.Int() }
+
+// returns a non-negative pseudo-random number in [0,n) as an int64. It panics if n <= 0.
+func Int63n(n int64) int64 { return rnd.Int63n(n) }
+
+// returns a non-negative pseudo-random number in [0,n) as an int32. It panics if n <= 0.
+func Int31n(n int32) int32 { return rnd.Int31n(n) }
+
+// returns a non-negative pseudo-random number in [0,n) as an int. It panics if n <= 0.
+func Intn(n int) int { return rnd.Intn(n) }
+
+// returns a pseudo-random number in [0.0,1.0) as a float64
+func Float64() float64 { return rnd.Float64() }
+
+// returns a pseudo-random number in [0.0,1.0) as a float32
+func Float32() float32 { return rnd.Float32() }
+
+// returns a pseudo-random permutation of the integers [0,n)
+func Perm(n int) []int { return def.Perm(n) }
+
+// pseudo-randomizes the order of elements, swap swaps the elements with indexes i and j.
+// It panics if n < 0.
+func Shuffle(n int, swap func(i, j int)) { def.ShuffleFunc(n, swap) }
+
+// fills p with pseudo-random bytes. It always returns len(p) and a nil error.
+func Read(p []byte) (n int, err error) {
+	return def.Read(p)
+}
+
+// returns a normally distributed float64 in [-math.MaxFloat64, +math.MaxFloat64]
+// with standard normal distribution (mean = 0, stddev = 1)
+func NormFloat64() float64 { return rnd.NormFloat64() }
+
+// returns an exponentially distributed float64 in (0, +math.MaxFloat64]
+// with an exponential distribution whose rate parameter (lambda) is 1
+func ExpFloat64() float64 { return rnd.ExpFloat64() }
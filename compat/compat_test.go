@@ -0,0 +1,106 @@
+package compat
+
+import (
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestRanges(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		if v := Int63(); v < 0 {
+			t.Fatalf("Int63 = %d", v)
+		}
+		if v := Int31(); v < 0 {
+			t.Fatalf("Int31 = %d", v)
+		}
+		if v := Int(); v < 0 {
+			t.Fatalf("Int = %d", v)
+		}
+		if v := Intn(7); v < 0 || v >= 7 {
+			t.Fatalf("Intn(7) = %d", v)
+		}
+		if v := Int63n(1 << 40); v < 0 || v >= 1<<40 {
+			t.Fatalf("Int63n(1<<40) = %d", v)
+		}
+		if v := Int31n(3); v < 0 || v >= 3 {
+			t.Fatalf("Int31n(3) = %d", v)
+		}
+		if v := Float64(); v < 0 || v >= 1 {
+			t.Fatalf("Float64 = %v", v)
+		}
+		if v := Float32(); v < 0 || v >= 1 {
+			t.Fatalf("Float32 = %v", v)
+		}
+		if v := ExpFloat64(); v <= 0 || math.IsInf(v, 0) {
+			t.Fatalf("ExpFloat64 = %v", v)
+		}
+	}
+}
+
+func TestIntnUniform(t *testing.T) {
+	const n, draws = 10, 100000
+	var counts [n]int
+	for i := 0; i < draws; i++ {
+		counts[Intn(n)]++
+	}
+	for v, c := range counts {
+		if c < draws/n*9/10 || c > draws/n*11/10 {
+			t.Errorf("Intn(%d) returned %d %d times out of %d, want about %d", n, v, c, draws, draws/n)
+		}
+	}
+}
+
+func TestPermShuffle(t *testing.T) {
+	p := Perm(50)
+	sorted := append([]int(nil), p...)
+	sort.Ints(sorted)
+	for i, v := range sorted {
+		if v != i {
+			t.Fatalf("Perm(50) = %v is not a permutation", p)
+		}
+	}
+
+	s := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+	sort.Ints(s)
+	for i, v := range s {
+		if v != i {
+			t.Fatalf("Shuffle lost elements: %v", s)
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	p := make([]byte, 64)
+	if n, err := Read(p); n != len(p) || err != nil {
+		t.Fatalf("Read = %d, %v", n, err)
+	}
+	zeros := 0
+	for _, b := range p {
+		if b == 0 {
+			zeros++
+		}
+	}
+	if zeros > 8 {
+		t.Errorf("Read left %d of 64 bytes zero", zeros)
+	}
+}
+
+func TestSeedIsNoOp(t *testing.T) {
+	Seed(1)
+	a := Int63()
+	Seed(1)
+	if b := Int63(); a == b {
+		t.Errorf("Int63 returned %d twice after Seed(1), the default instance must not be seedable", a)
+	}
+}
+
+func TestSeededPassThrough(t *testing.T) {
+	a, b := New(NewSource(42)), New(NewSource(42))
+	for i := 0; i < 10; i++ {
+		if x, y := a.Int63(), b.Int63(); x != y {
+			t.Fatalf("generators of the same seed diverged: %d != %d", x, y)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package random
+
+import "strings"
+
+// Crockford's check symbols for the values 32-36 of the mod 37 check
+const crockfordCheckSymbols = "*~$=U"
+
+// returns ID of length characters from Crockford's base32 alphabet, which has no I, L, O or U, for order numbers
+// and other IDs that are read aloud or typed. With withCheck a check symbol is appended as an extra character:
+// the ID's value mod 37, which catches any single wrong character and transposition. See VerifyCrockfordID.
+func (r *randomizer) CrockfordID(length int, withCheck bool) string {
+	id := r.StringFromPool(length, crockfordAlphabet)
+	if !withCheck {
+		return id
+	}
+	sum, _ := crockfordMod37(id)
+	return id + string((crockfordAlphabet + crockfordCheckSymbols)[sum])
+}
+
+// reports whether the last character of id is the correct check symbol for the characters before it.
+// Decoding follows Crockford's rules: case is ignored, I and L read as 1, O as 0 and hyphens are skipped.
+func VerifyCrockfordID(id string) bool {
+	id = strings.ToUpper(strings.ReplaceAll(id, "-", ""))
+	if id == "" {
+		return false
+	}
+	sum, ok := crockfordMod37(id[:len(id)-1])
+	if !ok {
+		return false
+	}
+	return strings.IndexByte(crockfordAlphabet+crockfordCheckSymbols, id[len(id)-1]) == sum
+}
+
+// returns value of the uppercase Crockford base32 string s mod 37, or false if s has invalid characters
+func crockfordMod37(s string) (int, bool) {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case 'I', 'L':
+			c = '1'
+		case 'O':
+			c = '0'
+		}
+		v := strings.IndexByte(crockfordAlphabet, c)
+		if v < 0 {
+			return 0, false
+		}
+		sum = (sum*32 + v) % 37
+	}
+	return sum, true
+}
@@ -0,0 +1,56 @@
+package random
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCrockfordCheckSymbols(t *testing.T) {
+	for _, tc := range []struct {
+		id   string
+		want bool
+	}{
+		{"00", true},
+		{"1Z1", false},
+		{"10*", true},
+		{"11~", true},
+		{"12$", true},
+		{"13=", true},
+		{"14U", true},
+		{"150", true},
+		{"1234567890ABCDEFGHJKMNPQRSTVWXYZ*", false},
+		{"10~", false},
+		{"01*", false},
+		{"1o*", true},
+		{"I0*", true},
+		{"l-0-*", true},
+		{"14u", true},
+		{"U0*", false},
+		{"", false},
+	} {
+		if got := VerifyCrockfordID(tc.id); got != tc.want {
+			t.Errorf("VerifyCrockfordID(%q) = %v, want %v", tc.id, got, tc.want)
+		}
+	}
+}
+
+func TestCrockfordID(t *testing.T) {
+	r := NewSFRand(WithEntropySource(bytes.NewReader(make([]byte, 64))), WithFallbackDisabled())
+	if got := r.CrockfordID(8, true); got != "000000000" {
+		t.Errorf("CrockfordID(8, true) = %q, want %q", got, "000000000")
+	}
+
+	r = NewSFRand()
+	for i := 0; i < 100; i++ {
+		id := r.CrockfordID(10, true)
+		if !VerifyCrockfordID(id) {
+			t.Fatalf("VerifyCrockfordID(%q) = false", id)
+		}
+		c := strings.IndexByte(crockfordAlphabet, id[3])
+		wrong := id[:3] + string(crockfordAlphabet[(c+1)%32]) + id[4:]
+		if VerifyCrockfordID(wrong) {
+			t.Errorf("VerifyCrockfordID(%q) = true after changing a character of %q", wrong, id)
+		}
+	}
+}
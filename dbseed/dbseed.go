@@ -0,0 +1,156 @@
+package dbseed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// number of rows inserted per statement when Options.BatchSize is not set
+const DefaultBatchSize = 100
+
+var (
+	ErrNoColumns   = errors.New("dbseed: table has no columns")
+	ErrNoGenerator = errors.New("dbseed: column has no generator")
+)
+
+// Column describes a single column and the generator producing its values.
+// Gen is called once per generated row; typically it closes over an SFRand, e.g.
+//
+//	dbseed.Column{Name: "age", Gen: func() any { return rnd.Int(18, 99) }}
+type Column struct {
+	Name string
+	Gen  func() any
+}
+
+// Table describes the table to seed. Name and column names are written into the
+// generated statements verbatim, so they must come from trusted code, never from user input.
+type Table struct {
+	Name    string
+	Columns []Column
+}
+
+// returns the bind parameter for the n-th (1-based) argument of a statement
+type Placeholder func(n int) string
+
+// placeholder style used by MySQL and SQLite
+func QuestionMark(int) string {
+	return "?"
+}
+
+// placeholder style used by PostgreSQL
+func Dollar(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+type Options struct {
+	// rows per INSERT statement, defaults to DefaultBatchSize
+	BatchSize int
+	// bind parameter style, defaults to QuestionMark
+	Placeholder Placeholder
+}
+
+// Execer is satisfied by *sql.DB, *sql.Tx and *sql.Conn. Pass a *sql.Tx to make seeding atomic.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// returns n generated rows for table, values ordered like table.Columns
+func Generate(table Table, n int) ([][]any, error) {
+	if err := validate(table); err != nil {
+		return nil, err
+	}
+	rows := make([][]any, n)
+	for i := range rows {
+		rows[i] = generateRow(table)
+	}
+	return rows, nil
+}
+
+// generates and inserts n rows into table, batching rows into multi-row INSERT statements.
+// It returns the number of rows inserted before the first error.
+func Seed(ctx context.Context, db Execer, table Table, n int, opts Options) (int, error) {
+	if err := validate(table); err != nil {
+		return 0, err
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	placeholder := opts.Placeholder
+	if placeholder == nil {
+		placeholder = QuestionMark
+	}
+
+	inserted := 0
+	args := make([]any, 0, batchSize*len(table.Columns))
+	for inserted < n {
+		rows := batchSize
+		if n-inserted < rows {
+			rows = n - inserted
+		}
+		args = args[:0]
+		for i := 0; i < rows; i++ {
+			args = append(args, generateRow(table)...)
+		}
+		if _, err := db.ExecContext(ctx, insertStatement(table, rows, placeholder), args...); err != nil {
+			return inserted, fmt.Errorf("dbseed: inserting into %s: %w", table.Name, err)
+		}
+		inserted += rows
+	}
+	return inserted, nil
+}
+
+func validate(table Table) error {
+	if len(table.Columns) == 0 {
+		return ErrNoColumns
+	}
+	for _, c := range table.Columns {
+		if c.Gen == nil {
+			return fmt.Errorf("%w: %s", ErrNoGenerator, c.Name)
+		}
+	}
+	return nil
+}
+
+func generateRow(table Table) []any {
+	row := make([]any, len(table.Columns))
+	for i, c := range table.Columns {
+		row[i] = c.Gen()
+	}
+	return row
+}
+
+// returns "INSERT INTO t (a, b) VALUES (?, ?), (?, ?)" for the given number of rows
+func insertStatement(table Table, rows int, placeholder Placeholder) string {
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO ")
+	sb.WriteString(table.Name)
+	sb.WriteString(" (")
+	for i, c := range table.Columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(c.Name)
+	}
+	sb.WriteString(") VALUES ")
+	arg := 1
+	for r := 0; r < rows; r++ {
+		if r > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteByte('(')
+		for i := range table.Columns {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(placeholder(arg))
+			arg++
+		}
+		sb.WriteByte(')')
+	}
+	return sb.String()
+}
@@ -0,0 +1,120 @@
+package dbseed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// records the statements it is given and fails from the failAt-th one on, if failAt > 0
+type recorder struct {
+	queries []string
+	args    [][]any
+	failAt  int
+}
+
+func (r *recorder) ExecContext(_ context.Context, query string, args ...any) (sql.Result, error) {
+	if r.failAt > 0 && len(r.queries)+1 >= r.failAt {
+		return nil, errors.New("connection reset")
+	}
+	r.queries = append(r.queries, query)
+	r.args = append(r.args, args)
+	return nil, nil
+}
+
+// returns a table whose columns count the values they generated
+func counterTable() Table {
+	id, name := 0, 0
+	return Table{Name: "users", Columns: []Column{
+		{Name: "id", Gen: func() any { id++; return id }},
+		{Name: "name", Gen: func() any { name++; return name * 10 }},
+	}}
+}
+
+func TestSeedPlaceholders(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		opts  Options
+		query string
+	}{
+		{"default", Options{BatchSize: 2}, "INSERT INTO users (id, name) VALUES (?, ?), (?, ?)"},
+		{"mysql", Options{BatchSize: 2, Placeholder: QuestionMark}, "INSERT INTO users (id, name) VALUES (?, ?), (?, ?)"},
+		{"postgres", Options{BatchSize: 2, Placeholder: Dollar}, "INSERT INTO users (id, name) VALUES ($1, $2), ($3, $4)"},
+	} {
+		db := &recorder{}
+		n, err := Seed(context.Background(), db, counterTable(), 2, tc.opts)
+		if err != nil || n != 2 {
+			t.Fatalf("%s: Seed = %d, %v", tc.name, n, err)
+		}
+		if len(db.queries) != 1 || db.queries[0] != tc.query {
+			t.Errorf("%s: queries = %q, want [%q]", tc.name, db.queries, tc.query)
+		}
+		if want := []any{1, 10, 2, 20}; !reflect.DeepEqual(db.args[0], want) {
+			t.Errorf("%s: args = %v, want %v", tc.name, db.args[0], want)
+		}
+	}
+}
+
+func TestSeedBatches(t *testing.T) {
+	for _, tc := range []struct {
+		n, batchSize int
+		rows         []int
+	}{
+		{5, 2, []int{2, 2, 1}},
+		{4, 2, []int{2, 2}},
+		{3, 0, []int{3}},
+		{DefaultBatchSize + 1, 0, []int{DefaultBatchSize, 1}},
+		{0, 2, nil},
+	} {
+		db := &recorder{}
+		n, err := Seed(context.Background(), db, counterTable(), tc.n, Options{BatchSize: tc.batchSize, Placeholder: Dollar})
+		if err != nil || n != tc.n {
+			t.Fatalf("Seed(%d, batch %d) = %d, %v", tc.n, tc.batchSize, n, err)
+		}
+		var rows []int
+		for _, args := range db.args {
+			rows = append(rows, len(args)/2)
+		}
+		if !reflect.DeepEqual(rows, tc.rows) {
+			t.Errorf("Seed(%d, batch %d) inserted batches of %v rows, want %v", tc.n, tc.batchSize, rows, tc.rows)
+		}
+	}
+}
+
+func TestSeedError(t *testing.T) {
+	db := &recorder{failAt: 3}
+	n, err := Seed(context.Background(), db, counterTable(), 10, Options{BatchSize: 3})
+	if err == nil || n != 6 {
+		t.Errorf("Seed = %d, %v, want 6 rows inserted before the error", n, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		table Table
+		want  error
+	}{
+		{Table{Name: "t"}, ErrNoColumns},
+		{Table{Name: "t", Columns: []Column{{Name: "a"}}}, ErrNoGenerator},
+		{counterTable(), nil},
+	} {
+		if _, err := Generate(tc.table, 1); !errors.Is(err, tc.want) {
+			t.Errorf("Generate(%v) = %v, want %v", tc.table.Columns, err, tc.want)
+		}
+		if _, err := Seed(context.Background(), &recorder{}, tc.table, 1, Options{}); !errors.Is(err, tc.want) {
+			t.Errorf("Seed(%v) = %v, want %v", tc.table.Columns, err, tc.want)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	rows, err := Generate(counterTable(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]any{{1, 10}, {2, 20}, {3, 30}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("Generate = %v, want %v", rows, want)
+	}
+}
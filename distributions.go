@@ -0,0 +1,86 @@
+package random
+
+import "math"
+
+// returns normally distributed float64 with the given mean and standard deviation, using the Marsaglia polar
+// method on top of Float64. It panics if stddev < 0.
+func (r *randomizer) NormFloat64(mean float64, stddev float64) float64 {
+	if !(stddev >= 0) {
+		panic("invalid argument to NormFloat64: stddev < 0")
+	}
+	for {
+		u := 2*r.Float64() - 1
+		v := 2*r.Float64() - 1
+		if s := u*u + v*v; s > 0 && s < 1 {
+			// the second value of the pair, v*factor, is dropped so the randomizer stays free of state
+			return mean + stddev*u*math.Sqrt(-2*math.Log(s)/s)
+		}
+	}
+}
+
+// returns exponentially distributed float64 with the given rate (events per unit), i.e. a mean of 1/rate,
+// e.g. for inter-arrival times. It panics if rate is not positive.
+func (r *randomizer) ExpFloat64(rate float64) float64 {
+	if !(rate > 0) {
+		panic("invalid argument to ExpFloat64: rate must be positive")
+	}
+	// 1-Float64() is in (0,1], which keeps the logarithm finite
+	return -math.Log(1-r.Float64()) / rate
+}
+
+// returns Poisson distributed count of events in an interval where lambda events are expected, using Knuth's
+// multiplication method for small lambda and Hörmann's PTRS transformed rejection for large lambda.
+// It panics if lambda is negative or not finite.
+func (r *randomizer) Poisson(lambda float64) int {
+	if !(lambda >= 0) || math.IsInf(lambda, 0) {
+		panic("invalid argument to Poisson: lambda must be finite and not negative")
+	}
+	if lambda < 30 {
+		limit := math.Exp(-lambda)
+		k := 0
+		for p := r.Float64(); p > limit; p *= r.Float64() {
+			k++
+		}
+		return k
+	}
+
+	slam := math.Sqrt(lambda)
+	logLam := math.Log(lambda)
+	b := 0.931 + 2.53*slam
+	a := -0.059 + 0.02483*b
+	invAlpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+	for {
+		u := r.Float64() - 0.5
+		v := r.Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + lambda + 0.43)
+		if us >= 0.07 && v <= vr {
+			return int(k)
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(invAlpha)-math.Log(a/(us*us)+b) <= -lambda+k*logLam-lg {
+			return int(k)
+		}
+	}
+}
+
+// reports true with probability p, e.g. Probability(0.25) is true for a quarter of the calls.
+// It panics if p is not in [0,1].
+func (r *randomizer) Probability(p float64) bool {
+	if !(p >= 0 && p <= 1) {
+		panic("invalid argument to Probability: p must be in [0,1]")
+	}
+	return r.Float64() < p
+}
+
+// reports true with probability 1/n. It panics if n < 1.
+func (r *randomizer) OneIn(n int) bool {
+	if n < 1 {
+		panic("invalid argument to OneIn: n must be at least 1")
+	}
+	return r.Int(0, n-1) == 0
+}
@@ -0,0 +1,64 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestPoissonPanics(t *testing.T) {
+	for _, lambda := range []float64{-1, math.NaN(), math.Inf(1), math.Inf(-1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Poisson(%v) did not panic", lambda)
+				}
+			}()
+			NewSFRand().Poisson(lambda)
+		}()
+	}
+}
+
+// both branches, Knuth's below 30 and PTRS from 30 on, must match the mean and variance of the distribution
+func TestPoissonMoments(t *testing.T) {
+	r := NewSFRand()
+	for _, lambda := range []float64{0, 0.5, 4, 29.9, 30, 100, 1000} {
+		const trials = 40000
+		sum, sumSq := 0.0, 0.0
+		for i := 0; i < trials; i++ {
+			k := r.Poisson(lambda)
+			if k < 0 {
+				t.Fatalf("Poisson(%v) = %d", lambda, k)
+			}
+			sum += float64(k)
+			sumSq += float64(k) * float64(k)
+		}
+		mean := sum / trials
+		variance := sumSq/trials - mean*mean
+		if math.Abs(mean-lambda) > 5*math.Sqrt(lambda/trials) {
+			t.Errorf("Poisson(%v) mean = %v", lambda, mean)
+		}
+		if math.Abs(variance-lambda) > 0.05*lambda {
+			t.Errorf("Poisson(%v) variance = %v", lambda, variance)
+		}
+	}
+}
+
+func TestPoissonProbabilities(t *testing.T) {
+	r := NewSFRand()
+	for _, lambda := range []float64{3, 50} {
+		const trials = 50000
+		counts := map[int]int{}
+		for i := 0; i < trials; i++ {
+			counts[r.Poisson(lambda)]++
+		}
+		for _, k := range []int{0, 1, int(lambda) - 5, int(lambda), int(lambda) + 5} {
+			if k < 0 {
+				continue
+			}
+			lg, _ := math.Lgamma(float64(k) + 1)
+			p := math.Exp(-lambda + float64(k)*math.Log(lambda) - lg)
+			checkBinomial(t, fmt.Sprintf("Poisson(%v) = %d", lambda, k), counts[k], trials, p)
+		}
+	}
+}
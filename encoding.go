@@ -0,0 +1,43 @@
+package random
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// returns nBytes pseudo-random bytes as a lowercase hex string of 2*nBytes characters
+func (r *randomizer) Hex(nBytes int) string {
+	return hex.EncodeToString(r.Bytes(nBytes))
+}
+
+// returns nBytes pseudo-random bytes in padded standard base64
+func (r *randomizer) Base64(nBytes int) string {
+	return base64.StdEncoding.EncodeToString(r.Bytes(nBytes))
+}
+
+// returns nBytes pseudo-random bytes in padded URL-safe base64
+func (r *randomizer) Base64URL(nBytes int) string {
+	return base64.URLEncoding.EncodeToString(r.Bytes(nBytes))
+}
+
+// returns nBytes pseudo-random bytes in unpadded standard base64
+func (r *randomizer) RawBase64(nBytes int) string {
+	return base64.RawStdEncoding.EncodeToString(r.Bytes(nBytes))
+}
+
+// returns nBytes pseudo-random bytes in unpadded URL-safe base64, the usual shape of session tokens and
+// OAuth state parameters
+func (r *randomizer) RawBase64URL(nBytes int) string {
+	return base64.RawURLEncoding.EncodeToString(r.Bytes(nBytes))
+}
+
+// returns nBytes pseudo-random bytes in padded standard base32
+func (r *randomizer) Base32(nBytes int) string {
+	return base32.StdEncoding.EncodeToString(r.Bytes(nBytes))
+}
+
+// returns nBytes pseudo-random bytes in unpadded standard base32, e.g. RawBase32(20) for a TOTP shared secret
+func (r *randomizer) RawBase32(nBytes int) string {
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(r.Bytes(nBytes))
+}
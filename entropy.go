@@ -0,0 +1,41 @@
+package random
+
+import "math"
+
+// returns bits of entropy of a string of length runes drawn uniformly from a pool of poolSize runes
+func EntropyBits(length int, poolSize int) float64 {
+	if length <= 0 || poolSize <= 1 {
+		return 0
+	}
+	return float64(length) * math.Log2(float64(poolSize))
+}
+
+// returns the shortest length of a string drawn uniformly from a pool of poolSize runes that carries
+// at least bits of entropy, e.g. LengthForEntropy(len(GetAlphaNumericPool()), 128) returns 22.
+// It panics if poolSize < 2 as no length reaches a positive target then.
+func LengthForEntropy(poolSize int, bits float64) int {
+	if bits <= 0 {
+		return 0
+	}
+	if poolSize < 2 {
+		panic("LengthForEntropy: poolSize must be at least 2")
+	}
+	// the tolerance keeps exact results like 128 bits from a pool of 16 from rounding up
+	return int(math.Ceil(bits/math.Log2(float64(poolSize)) - 1e-9))
+}
+
+// returns the probability that at least two of count strings of length runes, drawn uniformly from
+// a pool of poolSize runes, are equal. It uses the birthday bound 1 - e^(-count*(count-1) / 2N) where
+// N = poolSize^length, which is accurate whenever the result is small enough to matter.
+func CollisionProbability(poolSize int, length int, count uint64) float64 {
+	if count < 2 {
+		return 0
+	}
+	if poolSize <= 1 || length <= 0 {
+		return 1
+	}
+	// computed in log space, poolSize^length overflows float64 for realistic identifiers
+	logPairs := math.Log(float64(count)) + math.Log(float64(count-1)) - math.Ln2
+	logSpace := float64(length) * math.Log(float64(poolSize))
+	return -math.Expm1(-math.Exp(logPairs - logSpace))
+}
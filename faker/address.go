@@ -0,0 +1,80 @@
+package faker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// postal address composed from a locale's cities and street names. City, Region and the prefix of PostalCode
+// belong together, the street is made up.
+type Address struct {
+	Street string `json:"street"`
+	City   string `json:"city"`
+	// state, county or prefecture, empty where addresses usually omit it
+	Region     string `json:"region"`
+	PostalCode string `json:"postalCode"`
+	Country    string `json:"country"`
+}
+
+type city struct {
+	name   string
+	region string
+	// start of the city's postal codes, completed with random digits up to the locale's format
+	postal string
+}
+
+type addressData struct {
+	country string
+	streets []string
+	cities  []city
+	// returns street line for street name and house number
+	street func(f *Faker, name string, number int) string
+	// returns postal code starting with prefix
+	postal func(f *Faker, prefix string) string
+}
+
+// returns pseudo-random address in the locale, e.g. for e-commerce and shipping fixtures
+func (f *Faker) Address() Address {
+	a := f.data.address
+	c := a.cities[f.r.Int(0, len(a.cities)-1)]
+	return Address{
+		Street:     a.street(f, a.streets[f.r.Int(0, len(a.streets)-1)], f.r.Int(1, 199)),
+		City:       c.name,
+		Region:     c.region,
+		PostalCode: a.postal(f, c.postal),
+		Country:    a.country,
+	}
+}
+
+func numberFirst(_ *Faker, name string, number int) string {
+	return fmt.Sprintf("%d %s", number, name)
+}
+
+func numberLast(_ *Faker, name string, number int) string {
+	return fmt.Sprintf("%s %d", name, number)
+}
+
+// returns postal func completing prefixes with digits to length, inserting sep before the last tail digits
+func digits(length int, tail int, sep string) func(f *Faker, prefix string) string {
+	return func(f *Faker, prefix string) string {
+		var b strings.Builder
+		b.WriteString(prefix)
+		for b.Len() < length {
+			b.WriteByte(byte('0' + f.r.Int(0, 9)))
+		}
+		code := b.String()
+		if tail == 0 {
+			return code
+		}
+		return code[:length-tail] + sep + code[length-tail:]
+	}
+}
+
+// letters of the inward code of UK postcodes, which never uses C, I, K, M, O or V
+const ukInwardLetters = "ABDEFGHJLNPQRSTUWXYZ"
+
+// completes the outward code prefix, e.g. "M1", with an inward code such as "4BT"
+func ukPostcode(f *Faker, prefix string) string {
+	return fmt.Sprintf("%s %d%c%c", prefix, f.r.Int(0, 9),
+		ukInwardLetters[f.r.Int(0, len(ukInwardLetters)-1)], ukInwardLetters[f.r.Int(0, len(ukInwardLetters)-1)])
+}
@@ -0,0 +1,87 @@
+package faker
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAddress(t *testing.T) {
+	tests := []struct {
+		locale  string
+		street  *regexp.Regexp
+		postal  *regexp.Regexp
+		country string
+	}{
+		{"en-US", regexp.MustCompile(`^([1-9][0-9]{0,2}) (.+)$`), regexp.MustCompile(`^[0-9]{5}$`), "United States"},
+		{"en-GB", regexp.MustCompile(`^([1-9][0-9]{0,2}) (.+)$`), regexp.MustCompile(`^[A-Z]{1,2}[0-9][0-9A-Z]? [0-9][ABD-HJLNP-UW-Z]{2}$`), "United Kingdom"},
+		{"de-DE", regexp.MustCompile(`^(.+) ([1-9][0-9]{0,2})$`), regexp.MustCompile(`^[0-9]{5}$`), "Deutschland"},
+		{"fr-FR", regexp.MustCompile(`^([1-9][0-9]{0,2}) (.+)$`), regexp.MustCompile(`^[0-9]{5}$`), "France"},
+		{"es-ES", regexp.MustCompile(`^(.+), ([1-9][0-9]{0,2})$`), regexp.MustCompile(`^[0-9]{5}$`), "España"},
+		{"ja-JP", regexp.MustCompile(`^(.+)[1-5]丁目[0-9]+-[0-9]+$`), regexp.MustCompile(`^[0-9]{3}-[0-9]{4}$`), "日本"},
+	}
+	for _, tt := range tests {
+		f := newFaker(t, tt.locale)
+		data := locales[tt.locale].address
+		for i := 0; i < 200; i++ {
+			a := f.Address()
+			if !tt.street.MatchString(a.Street) || !streetNamed(data.streets, a.Street) {
+				t.Errorf("%s: Street = %q, want a street of the locale in its format", tt.locale, a.Street)
+			}
+			if !tt.postal.MatchString(a.PostalCode) {
+				t.Errorf("%s: PostalCode = %q, not in the locale's format", tt.locale, a.PostalCode)
+			}
+			if a.Country != tt.country {
+				t.Errorf("%s: Country = %q, want %q", tt.locale, a.Country, tt.country)
+			}
+			// city, region and postal code belong together
+			c, ok := cityNamed(data.cities, a.City)
+			if !ok {
+				t.Fatalf("%s: City = %q, not in the locale's data", tt.locale, a.City)
+			}
+			if a.Region != c.region || !strings.HasPrefix(a.PostalCode, c.postal) {
+				t.Errorf("%s: %+v does not match city %+v", tt.locale, a, c)
+			}
+		}
+	}
+}
+
+func TestDigits(t *testing.T) {
+	tests := []struct {
+		length, tail int
+		sep, prefix  string
+		want         *regexp.Regexp
+	}{
+		{5, 0, "", "10", regexp.MustCompile(`^10[0-9]{3}$`)},
+		{5, 0, "", "", regexp.MustCompile(`^[0-9]{5}$`)},
+		{7, 4, "-", "530", regexp.MustCompile(`^530-[0-9]{4}$`)},
+		{6, 3, " ", "12345", regexp.MustCompile(`^123 45[0-9]$`)},
+	}
+	f := newFaker(t, DefaultLocale)
+	for _, tt := range tests {
+		postal := digits(tt.length, tt.tail, tt.sep)
+		for i := 0; i < 50; i++ {
+			if got := postal(f, tt.prefix); !tt.want.MatchString(got) {
+				t.Errorf("digits(%d, %d, %q)(%q) = %q", tt.length, tt.tail, tt.sep, tt.prefix, got)
+			}
+		}
+	}
+}
+
+func streetNamed(streets []string, line string) bool {
+	for _, s := range streets {
+		if strings.Contains(line, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func cityNamed(cities []city, name string) (city, bool) {
+	for _, c := range cities {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return city{}, false
+}
@@ -0,0 +1,58 @@
+package faker
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/h4ckitt/random"
+)
+
+// domains reserved for documentation and testing by RFC 2606, so fake addresses never reach a real mailbox
+var emailDomains = []string{"example.com", "example.net", "example.org", "mail.test", "inbox.test", "corp.example"}
+
+// ASCII spellings of the accented letters in the name datasets
+var asciiLetters = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ä", "ae", "ç", "c", "è", "e", "é", "e", "ê", "e", "ë", "e",
+	"í", "i", "î", "i", "ï", "i", "ñ", "n", "ó", "o", "ô", "o", "ö", "oe", "ú", "u", "ü", "ue", "ß", "ss",
+)
+
+// returns syntactically valid email address derived from a fake name at one of the domains that RFC 2606
+// reserves for testing, such as example.com or mail.test, so it never belongs to a real person
+func (f *Faker) Email() string {
+	return f.EmailWithDomain(random.Choice(f.r, emailDomains))
+}
+
+// same as Email but at domain, which is used as given
+func (f *Faker) EmailWithDomain(domain string) string {
+	first, last := emailPart(f.FirstName()), emailPart(f.LastName())
+	if first == "" || last == "" {
+		// names of scripts without an ASCII spelling here are replaced by words
+		first, last = f.r.Word(), f.r.Word()
+	}
+	var local string
+	switch f.r.Int(0, 3) {
+	case 0:
+		local = first + "." + last
+	case 1:
+		local = first + last
+	case 2:
+		local = first[:1] + "." + last
+	default:
+		local = first + "_" + last
+	}
+	if f.r.Int(0, 2) == 0 {
+		local += strconv.Itoa(f.r.Int(1, 99))
+	}
+	return local + "@" + domain
+}
+
+// returns name lowercased and spelled in ASCII letters, or "" if it has other characters
+func emailPart(name string) string {
+	s := asciiLetters.Replace(strings.ToLower(name))
+	for _, c := range s {
+		if c < 'a' || c > 'z' {
+			return ""
+		}
+	}
+	return s
+}
@@ -0,0 +1,63 @@
+package faker
+
+import (
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// local part of the generated addresses: lowercase ASCII name parts or words, which may contain hyphens,
+// optionally joined by "." or "_" and followed by a number
+var localPart = regexp.MustCompile(`^[a-z-]+(\.|_)?[a-z-]+([1-9][0-9]?)?$`)
+
+func TestEmail(t *testing.T) {
+	for _, locale := range Locales() {
+		f := newFaker(t, locale)
+		for i := 0; i < 200; i++ {
+			addr := f.Email()
+			if _, err := mail.ParseAddress(addr); err != nil {
+				t.Fatalf("%s: Email() = %q is not a valid address: %v", locale, addr, err)
+			}
+			local, domain, _ := strings.Cut(addr, "@")
+			if !slices.Contains(emailDomains, domain) {
+				t.Errorf("%s: Email() = %q is not at a reserved domain", locale, addr)
+			}
+			if !localPart.MatchString(local) {
+				t.Errorf("%s: Email() = %q has unexpected local part", locale, addr)
+			}
+		}
+	}
+}
+
+func TestEmailWithDomain(t *testing.T) {
+	f := newFaker(t, DefaultLocale)
+	for i := 0; i < 50; i++ {
+		if addr := f.EmailWithDomain("corp.test"); !strings.HasSuffix(addr, "@corp.test") {
+			t.Errorf("EmailWithDomain(corp.test) = %q", addr)
+		}
+	}
+}
+
+func TestEmailPart(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"Smith", "smith"},
+		{"Müller", "mueller"},
+		{"Jürgen", "juergen"},
+		{"Lefèvre", "lefevre"},
+		{"Muñoz", "munoz"},
+		{"Álvarez", "alvarez"},
+		{"Schäfer", "schaefer"},
+		// no ASCII spelling
+		{"佐藤", ""},
+		{"O'Brien", ""},
+	}
+	for _, tt := range tests {
+		if got := emailPart(tt.name); got != tt.want {
+			t.Errorf("emailPart(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
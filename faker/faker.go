@@ -0,0 +1,74 @@
+// Package faker generates fake personal data such as names for fixtures and test databases, drawn from
+// embedded locale-aware datasets and the SFRand passed to New, so output is reproducible when that is seeded.
+package faker
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/h4ckitt/random"
+)
+
+// locale for callers without a preference
+const DefaultLocale = "en-US"
+
+// returned by New for a locale without embedded data, see Locales
+var ErrUnknownLocale = errors.New("faker: no data for locale")
+
+// Faker generates fake data for a locale. It is safe for concurrent use if its SFRand is.
+type Faker struct {
+	r      random.SFRand
+	locale string
+	data   *localeData
+}
+
+// returns Faker drawing from r with the data of locale, a BCP 47 tag such as "de-DE"
+func New(r random.SFRand, locale string) (*Faker, error) {
+	data, ok := locales[locale]
+	if !ok {
+		return nil, ErrUnknownLocale
+	}
+	return &Faker{r: r, locale: locale, data: data}, nil
+}
+
+// returns the locales with embedded data, sorted
+func Locales() []string {
+	return sortedKeys(locales)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// returns the locale of f
+func (f *Faker) Locale() string {
+	return f.locale
+}
+
+// returns given name common in the locale
+func (f *Faker) FirstName() string {
+	return random.Choice(f.r, f.data.firstNames)
+}
+
+// returns family name common in the locale
+func (f *Faker) LastName() string {
+	return random.Choice(f.r, f.data.lastNames)
+}
+
+// returns full name in the order and form of the locale, e.g. the family name first for ja-JP and two
+// family names for es-ES
+func (f *Faker) FullName() string {
+	switch f.data.nameOrder {
+	case familyFirst:
+		return f.LastName() + f.data.nameSeparator + f.FirstName()
+	case twoFamilyNames:
+		return strings.Join([]string{f.FirstName(), f.LastName(), f.LastName()}, f.data.nameSeparator)
+	}
+	return f.FirstName() + f.data.nameSeparator + f.LastName()
+}
@@ -0,0 +1,94 @@
+package faker
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/h4ckitt/random"
+)
+
+func newFaker(t *testing.T, locale string) *Faker {
+	t.Helper()
+	f, err := New(random.NewSFRand(), locale)
+	if err != nil {
+		t.Fatalf("New(%q): %v", locale, err)
+	}
+	return f
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(random.NewSFRand(), "xx-XX"); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("New(xx-XX) error = %v, want ErrUnknownLocale", err)
+	}
+	got := Locales()
+	if !slices.IsSorted(got) || !slices.Contains(got, DefaultLocale) {
+		t.Errorf("Locales() = %v, want sorted and containing %s", got, DefaultLocale)
+	}
+	for _, locale := range got {
+		if f := newFaker(t, locale); f.Locale() != locale {
+			t.Errorf("Locale() = %q, want %q", f.Locale(), locale)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	for _, locale := range Locales() {
+		f := newFaker(t, locale)
+		data := locales[locale]
+		for i := 0; i < 100; i++ {
+			if got := f.FirstName(); !slices.Contains(data.firstNames, got) {
+				t.Errorf("%s: FirstName() = %q, not in the locale's data", locale, got)
+			}
+			if got := f.LastName(); !slices.Contains(data.lastNames, got) {
+				t.Errorf("%s: LastName() = %q, not in the locale's data", locale, got)
+			}
+		}
+	}
+}
+
+func TestFullName(t *testing.T) {
+	tests := []struct {
+		locale string
+		// kinds of the name's parts in order, 'g' for given and 'f' for family names
+		parts string
+	}{
+		{"en-US", "gf"},
+		{"en-GB", "gf"},
+		{"de-DE", "gf"},
+		{"fr-FR", "gf"},
+		{"es-ES", "gff"},
+		{"ja-JP", "fg"},
+	}
+	for _, tt := range tests {
+		f := newFaker(t, tt.locale)
+		data := locales[tt.locale]
+		for i := 0; i < 100; i++ {
+			name := f.FullName()
+			parts := strings.Split(name, data.nameSeparator)
+			if len(parts) != len(tt.parts) {
+				t.Fatalf("%s: FullName() = %q, want %d parts", tt.locale, name, len(tt.parts))
+			}
+			for j, kind := range tt.parts {
+				names := data.firstNames
+				if kind == 'f' {
+					names = data.lastNames
+				}
+				if !slices.Contains(names, parts[j]) {
+					t.Errorf("%s: FullName() = %q, part %d is not a %c name", tt.locale, name, j, kind)
+				}
+			}
+		}
+	}
+}
+
+func TestSeeded(t *testing.T) {
+	a, _ := New(random.NewSeededSFRand(1), DefaultLocale)
+	b, _ := New(random.NewSeededSFRand(1), DefaultLocale)
+	for i := 0; i < 20; i++ {
+		if x, y := a.FullName(), b.FullName(); x != y {
+			t.Fatalf("equally seeded fakers differ: %q and %q", x, y)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package faker
+
+import "fmt"
+
+// how a locale composes full names
+type nameOrder int
+
+const (
+	givenFirst nameOrder = iota
+	familyFirst
+	// given name followed by the paternal and maternal family names
+	twoFamilyNames
+)
+
+type localeData struct {
+	firstNames    []string
+	lastNames     []string
+	nameOrder     nameOrder
+	nameSeparator string
+	address       addressData
+}
+
+var locales = map[string]*localeData{
+	"en-US": {
+		firstNames: []string{
+			"James", "Mary", "Robert", "Patricia", "John", "Jennifer", "Michael", "Linda", "David", "Elizabeth",
+			"William", "Barbara", "Richard", "Susan", "Joseph", "Jessica", "Thomas", "Sarah", "Christopher", "Karen",
+			"Charles", "Lisa", "Daniel", "Nancy", "Matthew", "Betty", "Anthony", "Sandra", "Mark", "Ashley",
+			"Emily", "Olivia", "Noah", "Liam", "Ava", "Ethan", "Sophia", "Mason", "Isabella", "Logan",
+		},
+		lastNames: []string{
+			"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis", "Rodriguez", "Martinez",
+			"Hernandez", "Lopez", "Gonzalez", "Wilson", "Anderson", "Thomas", "Taylor", "Moore", "Jackson", "Martin",
+			"Lee", "Perez", "Thompson", "White", "Harris", "Sanchez", "Clark", "Ramirez", "Lewis", "Robinson",
+			"Walker", "Young", "Allen", "King", "Wright", "Scott", "Torres", "Nguyen", "Hill", "Flores",
+		},
+		nameSeparator: " ",
+		address: addressData{
+			country: "United States",
+			streets: []string{
+				"Main St", "Oak St", "Maple Ave", "Cedar Ln", "Park Ave", "Elm St", "Washington Blvd", "Lake Dr",
+				"Hill Rd", "Pine St", "Sunset Blvd", "Church St", "Highland Ave", "Lincoln Way", "River Rd", "Mill St",
+			},
+			cities: []city{
+				{"New York", "NY", "100"}, {"Los Angeles", "CA", "900"}, {"Chicago", "IL", "606"},
+				{"Houston", "TX", "770"}, {"Phoenix", "AZ", "850"}, {"Philadelphia", "PA", "191"},
+				{"Seattle", "WA", "981"}, {"Denver", "CO", "802"}, {"Boston", "MA", "021"},
+				{"Atlanta", "GA", "303"}, {"Miami", "FL", "331"}, {"Portland", "OR", "972"},
+			},
+			street: numberFirst,
+			postal: digits(5, 0, ""),
+		},
+	},
+	"en-GB": {
+		firstNames: []string{
+			"Oliver", "Olivia", "George", "Amelia", "Harry", "Isla", "Jack", "Ava", "Jacob", "Emily",
+			"Charlie", "Sophie", "Thomas", "Grace", "Oscar", "Lily", "William", "Freya", "James", "Ella",
+			"Alfie", "Evie", "Joshua", "Poppy", "Arthur", "Charlotte", "Henry", "Florence", "Leo", "Isabelle",
+		},
+		lastNames: []string{
+			"Smith", "Jones", "Taylor", "Brown", "Williams", "Wilson", "Johnson", "Davies", "Patel", "Robinson",
+			"Wright", "Thompson", "Evans", "Walker", "White", "Roberts", "Green", "Hall", "Wood", "Jackson",
+			"Clarke", "Hughes", "Edwards", "Turner", "Hill", "Cooper", "Ward", "Morris", "Harris", "King",
+		},
+		nameSeparator: " ",
+		address: addressData{
+			country: "United Kingdom",
+			streets: []string{
+				"High Street", "Station Road", "Church Lane", "Victoria Road", "Green Lane", "Manor Road",
+				"Park Road", "Queen Street", "Mill Lane", "Kings Road", "New Road", "The Crescent",
+			},
+			cities: []city{
+				{"London", "", "SW1A"}, {"Manchester", "", "M1"}, {"Birmingham", "", "B2"},
+				{"Leeds", "", "LS1"}, {"Bristol", "", "BS1"}, {"Liverpool", "", "L1"},
+				{"Edinburgh", "", "EH1"}, {"Glasgow", "", "G1"}, {"Cardiff", "", "CF10"},
+				{"Belfast", "", "BT1"},
+			},
+			street: numberFirst,
+			postal: ukPostcode,
+		},
+	},
+	"de-DE": {
+		firstNames: []string{
+			"Maximilian", "Sophie", "Alexander", "Marie", "Paul", "Emma", "Leon", "Mia", "Lukas", "Hannah",
+			"Felix", "Lena", "Jonas", "Anna", "Elias", "Lea", "Finn", "Emilia", "Noah", "Johanna",
+			"Thomas", "Sabine", "Michael", "Ursula", "Andreas", "Petra", "Stefan", "Monika", "Jürgen", "Karin",
+		},
+		lastNames: []string{
+			"Müller", "Schmidt", "Schneider", "Fischer", "Weber", "Meyer", "Wagner", "Becker", "Schulz", "Hoffmann",
+			"Schäfer", "Koch", "Bauer", "Richter", "Klein", "Wolf", "Schröder", "Neumann", "Schwarz", "Zimmermann",
+			"Braun", "Krüger", "Hofmann", "Hartmann", "Lange", "Schmitt", "Werner", "Schmitz", "Krause", "Meier",
+		},
+		nameSeparator: " ",
+		address: addressData{
+			country: "Deutschland",
+			streets: []string{
+				"Hauptstraße", "Schulstraße", "Gartenstraße", "Bahnhofstraße", "Dorfstraße", "Bergstraße",
+				"Birkenweg", "Lindenstraße", "Kirchstraße", "Waldstraße", "Ringstraße", "Am Markt",
+			},
+			cities: []city{
+				{"Berlin", "Berlin", "10"}, {"Hamburg", "Hamburg", "20"}, {"München", "Bayern", "80"},
+				{"Köln", "Nordrhein-Westfalen", "50"}, {"Frankfurt am Main", "Hessen", "60"},
+				{"Stuttgart", "Baden-Württemberg", "70"}, {"Düsseldorf", "Nordrhein-Westfalen", "40"},
+				{"Leipzig", "Sachsen", "04"}, {"Dresden", "Sachsen", "01"}, {"Hannover", "Niedersachsen", "30"},
+			},
+			street: numberLast,
+			postal: digits(5, 0, ""),
+		},
+	},
+	"fr-FR": {
+		firstNames: []string{
+			"Gabriel", "Louise", "Léo", "Jade", "Raphaël", "Emma", "Arthur", "Alice", "Louis", "Chloé",
+			"Jules", "Lina", "Adam", "Léa", "Lucas", "Rose", "Hugo", "Anna", "Nathan", "Camille",
+			"Jean", "Marie", "Pierre", "Nathalie", "Michel", "Isabelle", "Philippe", "Sylvie", "Alain", "Céline",
+		},
+		lastNames: []string{
+			"Martin", "Bernard", "Thomas", "Petit", "Robert", "Richard", "Durand", "Dubois", "Moreau", "Laurent",
+			"Simon", "Michel", "Lefebvre", "Leroy", "Roux", "David", "Bertrand", "Morel", "Fournier", "Girard",
+			"Bonnet", "Dupont", "Lambert", "Fontaine", "Rousseau", "Vincent", "Muller", "Lefèvre", "Faure", "André",
+		},
+		nameSeparator: " ",
+		address: addressData{
+			country: "France",
+			streets: []string{
+				"rue de la Paix", "rue Victor Hugo", "avenue de la République", "rue de l'Église", "place de la Mairie",
+				"rue du Moulin", "boulevard Voltaire", "rue des Lilas", "rue Pasteur", "allée des Tilleuls",
+			},
+			cities: []city{
+				{"Paris", "Île-de-France", "750"}, {"Marseille", "Provence-Alpes-Côte d'Azur", "130"},
+				{"Lyon", "Auvergne-Rhône-Alpes", "690"}, {"Toulouse", "Occitanie", "310"},
+				{"Nice", "Provence-Alpes-Côte d'Azur", "060"}, {"Nantes", "Pays de la Loire", "440"},
+				{"Strasbourg", "Grand Est", "670"}, {"Bordeaux", "Nouvelle-Aquitaine", "330"},
+				{"Lille", "Hauts-de-France", "590"},
+			},
+			street: numberFirst,
+			postal: digits(5, 0, ""),
+		},
+	},
+	"es-ES": {
+		firstNames: []string{
+			"Hugo", "Lucía", "Martín", "Sofía", "Lucas", "Martina", "Mateo", "María", "Leo", "Julia",
+			"Daniel", "Paula", "Alejandro", "Valeria", "Pablo", "Emma", "Manuel", "Daniela", "Álvaro", "Carmen",
+			"Antonio", "Josefa", "José", "Isabel", "Francisco", "Ana", "Javier", "Laura", "David", "Cristina",
+		},
+		lastNames: []string{
+			"García", "Rodríguez", "González", "Fernández", "López", "Martínez", "Sánchez", "Pérez", "Gómez", "Martín",
+			"Jiménez", "Ruiz", "Hernández", "Díaz", "Moreno", "Muñoz", "Álvarez", "Romero", "Alonso", "Gutiérrez",
+			"Navarro", "Torres", "Domínguez", "Vázquez", "Ramos", "Gil", "Ramírez", "Serrano", "Blanco", "Molina",
+		},
+		nameOrder:     twoFamilyNames,
+		nameSeparator: " ",
+		address: addressData{
+			country: "España",
+			streets: []string{
+				"Calle Mayor", "Calle Real", "Avenida de la Constitución", "Plaza de España", "Calle del Sol",
+				"Calle de la Iglesia", "Paseo de la Castellana", "Calle Nueva", "Avenida de Andalucía", "Calle Ancha",
+			},
+			cities: []city{
+				{"Madrid", "Comunidad de Madrid", "28"}, {"Barcelona", "Cataluña", "08"},
+				{"Valencia", "Comunidad Valenciana", "46"}, {"Sevilla", "Andalucía", "41"},
+				{"Zaragoza", "Aragón", "50"}, {"Málaga", "Andalucía", "29"}, {"Bilbao", "País Vasco", "48"},
+				{"Palma", "Islas Baleares", "07"},
+			},
+			street: func(_ *Faker, name string, number int) string {
+				return fmt.Sprintf("%s, %d", name, number)
+			},
+			postal: digits(5, 0, ""),
+		},
+	},
+	"ja-JP": {
+		firstNames: []string{
+			"翔", "蓮", "大翔", "悠真", "湊", "陽翔", "樹", "大和", "健太", "拓也",
+			"陽葵", "結衣", "さくら", "美咲", "葵", "凛", "結菜", "花子", "愛", "優子",
+		},
+		lastNames: []string{
+			"佐藤", "鈴木", "高橋", "田中", "伊藤", "渡辺", "山本", "中村", "小林", "加藤",
+			"吉田", "山田", "佐々木", "山口", "松本", "井上", "木村", "林", "斎藤", "清水",
+		},
+		nameOrder:     familyFirst,
+		nameSeparator: " ",
+		address: addressData{
+			country: "日本",
+			streets: []string{"中央", "本町", "栄町", "緑町", "旭町", "幸町", "東町", "西町", "南町", "北町"},
+			cities: []city{
+				{"千代田区", "東京都", "100"}, {"新宿区", "東京都", "160"}, {"大阪市北区", "大阪府", "530"},
+				{"横浜市中区", "神奈川県", "231"}, {"名古屋市中区", "愛知県", "460"}, {"札幌市中央区", "北海道", "060"},
+				{"福岡市博多区", "福岡県", "812"}, {"京都市中京区", "京都府", "604"},
+			},
+			// chōme, block and building number
+			street: func(f *Faker, name string, number int) string {
+				return fmt.Sprintf("%s%d丁目%d-%d", name, f.r.Int(1, 5), f.r.Int(1, 30), number%30+1)
+			},
+			postal: digits(7, 4, "-"),
+		},
+	},
+}
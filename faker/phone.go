@@ -0,0 +1,65 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+)
+
+// returned by PhoneNumber for a region without numbers reserved for fiction, see PhoneRegions
+var ErrUnknownRegion = errors.New("faker: no fictional phone numbers for region")
+
+// formats a pseudo-random number from the ranges a region's regulator reserves for fiction
+type phoneFormat func(f *Faker) string
+
+var phoneFormats = map[string]phoneFormat{
+	// NANP reserves 555-0100 to 555-0199 in every area code
+	"US": nanpNumber,
+	"CA": nanpNumber,
+	// Ofcom's drama numbers for London, no geographic area and mobiles
+	"GB": func(f *Faker) string {
+		switch f.r.Int(0, 2) {
+		case 0:
+			return fmt.Sprintf("020 7946 0%03d", f.r.Int(0, 999))
+		case 1:
+			return fmt.Sprintf("01632 960%03d", f.r.Int(0, 999))
+		}
+		return fmt.Sprintf("07700 900%03d", f.r.Int(0, 999))
+	},
+	// ARCEP's blocks for audiovisual works, one per geographic zone and for mobiles
+	"FR": func(f *Faker) string {
+		block := []string{"01 99 00", "02 61 91", "03 53 01", "04 65 71", "05 36 49", "06 39 98"}[f.r.Int(0, 5)]
+		return fmt.Sprintf("%s %02d %02d", block, f.r.Int(0, 99), f.r.Int(0, 99))
+	},
+	// ACMA's ranges for drama productions in the four geographic areas
+	"AU": func(f *Faker) string {
+		prefix := []string{"(02) 5550", "(03) 7010", "(07) 5550", "(08) 7010"}[f.r.Int(0, 3)]
+		return fmt.Sprintf("%s %04d", prefix, f.r.Int(0, 9999))
+	},
+}
+
+// returns phone number formatted as usual in region, an ISO 3166-1 alpha-2 code such as "US", from the
+// ranges its regulator reserves for films and fiction, so test data never contains a real number
+func (f *Faker) PhoneNumber(region string) (string, error) {
+	format, ok := phoneFormats[region]
+	if !ok {
+		return "", ErrUnknownRegion
+	}
+	return format(f), nil
+}
+
+// returns the regions supported by PhoneNumber, sorted
+func PhoneRegions() []string {
+	return sortedKeys(phoneFormats)
+}
+
+// returns "(NPA) 555-01XX" with a valid area code: first digit 2-9, second digit not 9 and not of form N11
+func nanpNumber(f *Faker) string {
+	var npa int
+	for {
+		npa = f.r.Int(200, 989)
+		if npa/10%10 != 9 && npa%100 != 11 {
+			break
+		}
+	}
+	return fmt.Sprintf("(%d) 555-01%02d", npa, f.r.Int(0, 99))
+}
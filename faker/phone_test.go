@@ -0,0 +1,48 @@
+package faker
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestPhoneNumber(t *testing.T) {
+	tests := []struct {
+		region string
+		// ranges reserved for fiction in the region's usual format
+		want *regexp.Regexp
+	}{
+		{"US", regexp.MustCompile(`^\(([2-9][0-8][0-9])\) 555-01[0-9]{2}$`)},
+		{"CA", regexp.MustCompile(`^\(([2-9][0-8][0-9])\) 555-01[0-9]{2}$`)},
+		{"GB", regexp.MustCompile(`^(020 7946 0[0-9]{3}|01632 960[0-9]{3}|07700 900[0-9]{3})$`)},
+		{"FR", regexp.MustCompile(`^(01 99 00|02 61 91|03 53 01|04 65 71|05 36 49|06 39 98) [0-9]{2} [0-9]{2}$`)},
+		{"AU", regexp.MustCompile(`^(\(0[27]\) 5550|\(0[38]\) 7010) [0-9]{4}$`)},
+	}
+	f := newFaker(t, DefaultLocale)
+	for _, tt := range tests {
+		for i := 0; i < 200; i++ {
+			n, err := f.PhoneNumber(tt.region)
+			if err != nil {
+				t.Fatalf("PhoneNumber(%s): %v", tt.region, err)
+			}
+			m := tt.want.FindStringSubmatch(n)
+			if m == nil {
+				t.Fatalf("PhoneNumber(%s) = %q, not in a fictional range", tt.region, n)
+			}
+			if tt.region == "US" || tt.region == "CA" {
+				// N11 codes are reserved for services
+				if npa, _ := strconv.Atoi(m[1]); npa%100 == 11 {
+					t.Errorf("PhoneNumber(%s) = %q has N11 area code", tt.region, n)
+				}
+			}
+		}
+	}
+	if !slices.Equal(PhoneRegions(), []string{"AU", "CA", "FR", "GB", "US"}) {
+		t.Errorf("PhoneRegions() = %v, not the tested regions", PhoneRegions())
+	}
+	if _, err := f.PhoneNumber("DE"); !errors.Is(err, ErrUnknownRegion) {
+		t.Errorf("PhoneNumber(DE) error = %v, want ErrUnknownRegion", err)
+	}
+}
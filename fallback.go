@@ -0,0 +1,24 @@
+package random
+
+import "encoding/binary"
+
+// returns the seed of the fallback generator for the 64-bit seed v
+func expandSeed(v uint64) [32]byte {
+	var seed [32]byte
+	binary.LittleEndian.PutUint64(seed[:], v)
+	return seed
+}
+
+// returns how often this SFRand used its insecure fallback generator because the entropy source failed, so
+// that operators can alert when a host starts serving degraded randomness
+func (r *randomizer) FallbackCount() uint64 {
+	return r.fallbacks.Load()
+}
+
+// records that op fell back to the fallback generator because of err and calls the OnFallback hook
+func (r *randomizer) fellBack(op string, err error) {
+	r.fallbacks.Add(1)
+	if r.onFallback != nil {
+		r.onFallback(op, err)
+	}
+}
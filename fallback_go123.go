@@ -0,0 +1,14 @@
+//go:build go1.23
+
+package random
+
+import (
+	"io"
+	mathrand "math/rand/v2"
+)
+
+// returns the generator used when the entropy source fails, math/rand/v2's ChaCha8 seeded with seed.
+// It is not safe for concurrent use.
+func newFallback(seed [32]byte) io.Reader {
+	return mathrand.NewChaCha8(seed)
+}
@@ -0,0 +1,15 @@
+//go:build !go1.23
+
+package random
+
+import (
+	"encoding/binary"
+	"io"
+	mathrand "math/rand"
+)
+
+// returns the generator used when the entropy source fails, math/rand seeded with the first 8 bytes of seed,
+// as math/rand/v2's ChaCha8 is not an io.Reader before go1.23. It is not safe for concurrent use.
+func newFallback(seed [32]byte) io.Reader {
+	return mathrand.New(mathrand.NewSource(int64(binary.LittleEndian.Uint64(seed[:]))))
+}
@@ -0,0 +1,79 @@
+package random
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// returns SFRand backed by the Go runtime's pseudo-random number generator instead of crypto/rand, the one
+// behind the top-level functions of math/rand/v2, or math/rand before go1.22. Its output is NOT suitable for
+// secrets, tokens or anything else an attacker must not predict, but it is much faster, which matters for
+// simulations, load tests and fixture generation: the runtime keeps a generator per thread, so concurrent
+// goroutines never wait on a lock, and Int and the byte methods draw from it directly. Compare both
+// constructors on the target platform with the benchmarks in fast_test.go:
+//
+//	go test -run '^$' -bench 'SFRand|FastInsecure'
+//
+// The runtime seeds its generator itself. Options other than WithLogger have no effect.
+func NewFastInsecure(opts ...Option) SFRand {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &randomizer{src: runtimeSource{}, fast: true, logger: o.logger, insecure: true}
+}
+
+// io.Reader and uint64Source over the runtime's generator, it never fails
+type runtimeSource struct{}
+
+func (runtimeSource) Uint64() uint64 {
+	return runtimeUint64()
+}
+
+func (runtimeSource) Read(p []byte) (int, error) {
+	fastRead(p)
+	return len(p), nil
+}
+
+// fills p from a wyrand stream seeded from the runtime's generator, which is several times faster than taking
+// every 8 bytes from the runtime. The stream's state lives on the stack, so concurrent calls share nothing.
+func fastRead(p []byte) {
+	s := runtimeUint64()
+	for len(p) >= 8 {
+		binary.LittleEndian.PutUint64(p, wyrand(&s))
+		p = p[8:]
+	}
+	if len(p) > 0 {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], wyrand(&s))
+		copy(p, b[:])
+	}
+}
+
+// advances s and returns its next value, see https://github.com/wangyi-fudan/wyhash
+func wyrand(s *uint64) uint64 {
+	*s += 0xa0761d6478bd642f
+	hi, lo := bits.Mul64(*s, *s^0xe7037ed1a0b428db)
+	return hi ^ lo
+}
+
+// returns uniformly distributed int between min and max, inclusive, from the runtime's generator. It maps
+// values into the span by multiplication rather than division, rejecting only the few that would bias it,
+// see Lemire, "Fast Random Integer Generation in an Interval".
+func fastInt(min int, max int) int {
+	if max < min {
+		panic(fmt.Sprintf("invalid argument to Int: max < min (min %d, max %d)", min, max))
+	}
+	n := uint64(max) - uint64(min) + 1
+	if n == 0 {
+		return int(runtimeUint64())
+	}
+	hi, lo := bits.Mul64(runtimeUint64(), n)
+	if lo < n {
+		for limit := -n % n; lo < limit; {
+			hi, lo = bits.Mul64(runtimeUint64(), n)
+		}
+	}
+	return min + int(hi)
+}
@@ -0,0 +1,10 @@
+//go:build go1.22
+
+package random
+
+import mathrand "math/rand/v2"
+
+// returns value of the runtime's per-thread ChaCha8 generator, see NewFastInsecure
+func runtimeUint64() uint64 {
+	return mathrand.Uint64()
+}
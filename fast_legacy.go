@@ -0,0 +1,11 @@
+//go:build !go1.22
+
+package random
+
+import mathrand "math/rand"
+
+// returns value of the runtime's per-thread generator behind math/rand's top-level functions, as
+// math/rand/v2 does not exist before go1.22. It is only lock-free as long as nothing calls math/rand.Seed.
+func runtimeUint64() uint64 {
+	return mathrand.Uint64()
+}
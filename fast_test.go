@@ -0,0 +1,108 @@
+package random
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFastInt(t *testing.T) {
+	r := NewFastInsecure()
+	tests := []struct {
+		min, max int
+	}{
+		{0, 0},
+		{-5, 5},
+		{0, 6},
+		{math.MinInt, math.MinInt + 2},
+		{math.MaxInt - 2, math.MaxInt},
+		{math.MinInt, math.MaxInt},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 1000; i++ {
+			if v := r.Int(tt.min, tt.max); v < tt.min || v > tt.max {
+				t.Fatalf("Int(%d, %d) = %d", tt.min, tt.max, v)
+			}
+		}
+	}
+
+	const trials = 70000
+	counts := make([]int, 7)
+	for i := 0; i < trials; i++ {
+		counts[r.Int(0, 6)]++
+	}
+	for v, count := range counts {
+		checkBinomial(t, fmt.Sprintf("Int(0, 6) == %d", v), count, trials, 1.0/7)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Int(1, 0) did not panic")
+		}
+	}()
+	r.Int(1, 0)
+}
+
+func TestFastBytes(t *testing.T) {
+	r := NewFastInsecure()
+	if !IsInsecure(r) {
+		t.Error("NewFastInsecure is not reported as insecure")
+	}
+	for n := 0; n <= 33; n++ {
+		// every byte, including those after the last full 8, must be drawn
+		var tail byte
+		for i := 0; i < 64; i++ {
+			b := r.Bytes(n)
+			if len(b) != n {
+				t.Fatalf("Bytes(%d) has %d bytes", n, len(b))
+			}
+			if n > 0 {
+				tail |= b[n-1]
+			}
+		}
+		if n > 0 && tail == 0 {
+			t.Errorf("Bytes(%d) always ends in 0", n)
+		}
+	}
+	if a, b := r.Bytes(32), r.Bytes(32); bytes.Equal(a, b) {
+		t.Errorf("consecutive Bytes(32) are equal: %x", a)
+	}
+}
+
+func benchmarkInt(b *testing.B, r SFRand) {
+	for i := 0; i < b.N; i++ {
+		r.Int(0, 1<<30)
+	}
+}
+
+func benchmarkBytes(b *testing.B, r SFRand) {
+	for i := 0; i < b.N; i++ {
+		r.Bytes(32)
+	}
+}
+
+func benchmarkString(b *testing.B, r SFRand) {
+	pool := GetAlphaNumericPool()
+	for i := 0; i < b.N; i++ {
+		r.String(16, pool)
+	}
+}
+
+func BenchmarkSFRandInt(b *testing.B)          { benchmarkInt(b, NewSFRand()) }
+func BenchmarkFastInsecureInt(b *testing.B)    { benchmarkInt(b, NewFastInsecure()) }
+func BenchmarkSFRandBytes(b *testing.B)        { benchmarkBytes(b, NewSFRand()) }
+func BenchmarkFastInsecureBytes(b *testing.B)  { benchmarkBytes(b, NewFastInsecure()) }
+func BenchmarkSFRandString(b *testing.B)       { benchmarkString(b, NewSFRand()) }
+func BenchmarkFastInsecureString(b *testing.B) { benchmarkString(b, NewFastInsecure()) }
+
+func benchmarkIntParallel(b *testing.B, r SFRand) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			r.Int(0, 1<<30)
+		}
+	})
+}
+
+func BenchmarkSFRandIntParallel(b *testing.B)       { benchmarkIntParallel(b, NewSFRand()) }
+func BenchmarkFastInsecureIntParallel(b *testing.B) { benchmarkIntParallel(b, NewFastInsecure()) }
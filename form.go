@@ -0,0 +1,198 @@
+package random
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"strings"
+)
+
+// configures QueryString and MultipartForm
+type FormOption func(*formOptions)
+
+type formOptions struct {
+	edgeCases int
+}
+
+// makes roughly percent of the generated parameters, fields and files edge cases, such as empty or repeated
+// keys, reserved and non-ASCII characters, malformed escapes or unusual file names. Without it only
+// plain, well-formed values are generated.
+func EdgeCases(percent int) FormOption {
+	return func(o *formOptions) {
+		o.edgeCases = percent
+	}
+}
+
+func newFormOptions(opts []FormOption) formOptions {
+	var o formOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// reports whether the next value should be an edge case
+func edgeCase(r SFRand, o formOptions) bool {
+	return o.edgeCases > 0 && r.Int(0, 99) < o.edgeCases
+}
+
+// values with characters that need escaping, or that parsers tend to mishandle
+var formEdgeValues = []string{
+	"",
+	" ",
+	"a b",
+	"a+b",
+	"&=?#/%",
+	"100%",
+	"naïve café",
+	"日本語",
+	"😀",
+	"\x00",
+	"line\r\nbreak",
+	"\"quoted\"",
+	"<script>alert(1)</script>",
+	"' OR '1'='1",
+	"../../etc/passwd",
+}
+
+// returns URL-encoded query string of params key=value pairs drawn with r, without the leading '?',
+// e.g. "kx7=Qm2b&id=83Hd". See EdgeCases for mixing in malformed and unusual parameters.
+func QueryString(r SFRand, params int, opts ...FormOption) string {
+	o := newFormOptions(opts)
+	pairs := make([]string, 0, params)
+	var lastKey string
+	for i := 0; i < params; i++ {
+		key := formName(r)
+		value := r.String(r.Int(0, 12), GetAlphaNumericPool())
+		pair := url.QueryEscape(key) + "=" + url.QueryEscape(value)
+		if edgeCase(r, o) {
+			pair = queryEdgePair(r, key, lastKey)
+		}
+		lastKey = key
+		pairs = append(pairs, pair)
+	}
+	return strings.Join(pairs, "&")
+}
+
+func queryEdgePair(r SFRand, key string, lastKey string) string {
+	value := formEdgeValues[r.Int(0, len(formEdgeValues)-1)]
+	switch r.Int(0, 9) {
+	case 0: // key without '='
+		return url.QueryEscape(key)
+	case 1: // empty key
+		return "=" + url.QueryEscape(value)
+	case 2: // repeated key
+		if lastKey == "" {
+			lastKey = key
+		}
+		return url.QueryEscape(lastKey) + "=" + url.QueryEscape(value)
+	case 3: // array style key
+		return url.QueryEscape(key+"[]") + "=" + url.QueryEscape(value)
+	case 4: // malformed percent escapes
+		return url.QueryEscape(key) + "=" + []string{"%", "%z", "%zz", "%%", "%G1", "%E2%82"}[r.Int(0, 5)]
+	case 5: // space encoded as %20 instead of '+'
+		return url.QueryEscape(key) + "=" + url.PathEscape(value)
+	case 6: // unescaped value
+		return url.QueryEscape(key) + "=" + strings.NewReplacer("&", "", "#", "").Replace(value)
+	case 7: // very long value
+		return url.QueryEscape(key) + "=" + r.String(r.Int(1024, 8192), GetAlphaNumericPool())
+	default: // reserved or non-ASCII characters, properly escaped
+		return url.QueryEscape(key) + "=" + url.QueryEscape(value)
+	}
+}
+
+// returns multipart/form-data body with fields text fields and files file parts drawn with r, together with
+// the Content-Type header value carrying its boundary. See EdgeCases for mixing in unusual boundaries, names
+// and contents.
+func MultipartForm(r SFRand, fields int, files int, opts ...FormOption) (body []byte, contentType string) {
+	o := newFormOptions(opts)
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	// SetBoundary only fails for invalid boundaries, which multipartBoundary never returns
+	_ = w.SetBoundary(multipartBoundary(r, o))
+
+	for i := 0; i < fields; i++ {
+		name := formName(r)
+		value := r.String(r.Int(0, 32), GetAlphaNumericPool())
+		if edgeCase(r, o) {
+			value = formEdgeValues[r.Int(0, len(formEdgeValues)-1)]
+			// names go into the part header, where line breaks and NULs would break the body's structure
+			if n := formEdgeValues[r.Int(0, len(formEdgeValues)-1)]; r.Bool() && !strings.ContainsAny(n, "\x00\r\n") {
+				name = n
+			}
+		}
+		// writes to a bytes.Buffer cannot fail
+		_ = w.WriteField(name, value)
+	}
+
+	for i := 0; i < files; i++ {
+		h := make(textproto.MIMEHeader)
+		filename := formName(r) + ".bin"
+		content := r.Bytes(r.Int(1, 1024))
+		contentType := "application/octet-stream"
+		if edgeCase(r, o) {
+			filename, content, contentType = multipartEdgeFile(r, w.Boundary())
+		}
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, formName(r), quoteEscaper.Replace(filename)))
+		if contentType != "" {
+			h.Set("Content-Type", contentType)
+		}
+		part, _ := w.CreatePart(h)
+		_, _ = part.Write(content)
+	}
+	_ = w.Close()
+	return buf.Bytes(), w.FormDataContentType()
+}
+
+// same escaping as mime/multipart uses for names in Content-Disposition
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// characters allowed in a multipart boundary besides letters and digits, see RFC 2046
+const boundarySpecials = "'()+_,-./:=?"
+
+func multipartBoundary(r SFRand, o formOptions) string {
+	if !edgeCase(r, o) {
+		return r.String(30, []rune("0123456789abcdef"))
+	}
+	// maximum length boundary using every allowed character, spaces included but not at the end
+	pool := append(GetAlphaNumericPool(), []rune(boundarySpecials+" ")...)
+	b := []rune(r.String(69, pool))
+	return string(b) + string(r.Rune(GetAlphaNumericPool()))
+}
+
+func multipartEdgeFile(r SFRand, boundary string) (filename string, content []byte, contentType string) {
+	filenames := []string{
+		"",
+		"no extension",
+		"../../etc/passwd",
+		`C:\Windows\win.ini`,
+		"résumé (final).pdf",
+		"файл.txt",
+		"a\"b.txt",
+		".htaccess",
+		strings.Repeat("long", 64) + ".txt",
+	}
+	switch r.Int(0, 5) {
+	case 0: // empty file
+		content = []byte{}
+	case 1: // data resembling the boundary delimiter
+		content = []byte("\r\n--" + boundary[:len(boundary)/2] + "\r\n")
+	case 2:
+		content = []byte("--\r\n\r\n--")
+	case 3:
+		content = bytes.Repeat([]byte{0}, r.Int(1, 64))
+	case 4:
+		content = []byte("\xef\xbb\xbfBOM prefixed text")
+	default: // large file
+		content = r.Bytes(r.Int(64*1024, 256*1024))
+	}
+	contentTypes := []string{"", "text/plain", "application/x-msdownload", "image/png", "invalid/"}
+	return filenames[r.Int(0, len(filenames)-1)], content, contentTypes[r.Int(0, len(contentTypes)-1)]
+}
+
+// returns short lowercase parameter or field name
+func formName(r SFRand) string {
+	return r.String(1, GetAlphabeticLowercasePool()) + r.String(r.Int(1, 9), GetAlphaNumericLowercasePool())
+}
@@ -0,0 +1,65 @@
+package random
+
+import (
+	"bytes"
+	"mime"
+	"mime/multipart"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestQueryString(t *testing.T) {
+	r := NewSFRand()
+	for _, params := range []int{0, 1, 20} {
+		q := QueryString(r, params)
+		values, err := url.ParseQuery(q)
+		if err != nil {
+			t.Fatalf("QueryString(%d) = %q does not parse: %v", params, q, err)
+		}
+		n := 0
+		for _, v := range values {
+			n += len(v)
+		}
+		if n != params {
+			t.Errorf("QueryString(%d) = %q has %d parameters", params, q, n)
+		}
+	}
+}
+
+func TestMultipartForm(t *testing.T) {
+	r := NewSFRand()
+	tests := []struct {
+		fields, files, edgeCases int
+	}{
+		{0, 0, 0},
+		{3, 2, 0},
+		{5, 5, 100},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 20; i++ {
+			body, contentType := MultipartForm(r, tt.fields, tt.files, EdgeCases(tt.edgeCases))
+			mediaType, params, err := mime.ParseMediaType(contentType)
+			if err != nil || mediaType != "multipart/form-data" {
+				t.Fatalf("MultipartForm content type %q: %v", contentType, err)
+			}
+			fields, files := 0, 0
+			mr := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+			for {
+				p, err := mr.NextPart()
+				if err != nil {
+					break
+				}
+				if p.FileName() != "" || strings.Contains(p.Header.Get("Content-Disposition"), "filename=") {
+					files++
+				} else {
+					fields++
+				}
+			}
+			if fields != tt.fields || files != tt.files {
+				t.Fatalf("MultipartForm(%d, %d, EdgeCases(%d)) has %d fields and %d files",
+					tt.fields, tt.files, tt.edgeCases, fields, files)
+			}
+		}
+	}
+}
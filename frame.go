@@ -0,0 +1,241 @@
+package random
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// fixed size header field of a binary frame
+type FrameField struct {
+	Name string
+	// size in bytes, 1, 2, 4 or 8
+	Size int
+	// values the field may take, nil for any value that fits in Size bytes
+	Values []uint64
+}
+
+// describes frames generated by a FrameGenerator. A frame is laid out as the Header fields in order,
+// followed by the payload length in LengthPrefix bytes (if non-zero) and the payload itself.
+type FrameConfig struct {
+	Header []FrameField
+	// size in bytes of the payload length, 0 for none, otherwise 1, 2, 4 or 8
+	LengthPrefix int
+	// byte order of header fields and length, defaults to binary.BigEndian
+	ByteOrder binary.ByteOrder
+	// bounds of the payload length, inclusive
+	MinPayload int
+	MaxPayload int
+	// percentage of frames, 0-100, that contain an intentional malformation
+	MalformedPercent int
+}
+
+// kind of intentional malformation of a Frame
+type Malformation int
+
+const (
+	NotMalformed Malformation = iota
+	// length prefix claims more bytes than the payload has
+	LengthTooLarge
+	// length prefix claims fewer bytes than the payload has
+	LengthTooSmall
+	// length prefix holds its maximum value
+	LengthOverflow
+	// frame is cut off at a pseudo-random position
+	Truncated
+	// a header field holds a value outside of its Values
+	InvalidField
+	// pseudo-random bytes follow the payload
+	TrailingGarbage
+)
+
+func (m Malformation) String() string {
+	switch m {
+	case NotMalformed:
+		return "NotMalformed"
+	case LengthTooLarge:
+		return "LengthTooLarge"
+	case LengthTooSmall:
+		return "LengthTooSmall"
+	case LengthOverflow:
+		return "LengthOverflow"
+	case Truncated:
+		return "Truncated"
+	case InvalidField:
+		return "InvalidField"
+	case TrailingGarbage:
+		return "TrailingGarbage"
+	}
+	return fmt.Sprintf("Malformation(%d)", int(m))
+}
+
+// generated frame together with the malformation applied to it, for asserting on parser behavior
+type Frame struct {
+	Data         []byte
+	Malformation Malformation
+}
+
+// generates binary frames for fuzzing wire protocol parsers. Its output is fully determined by the values
+// drawn from its SFRand, so a deterministic SFRand reproduces the same frames.
+type FrameGenerator struct {
+	r   SFRand
+	cfg FrameConfig
+}
+
+// returns FrameGenerator drawing from r. It panics if cfg describes field or length sizes other than
+// 1, 2, 4 or 8 bytes, or an invalid payload range.
+func NewFrameGenerator(r SFRand, cfg FrameConfig) *FrameGenerator {
+	for _, f := range cfg.Header {
+		if !validFieldSize(f.Size) {
+			panic(fmt.Sprintf("NewFrameGenerator: invalid size %d of field %s", f.Size, f.Name))
+		}
+	}
+	if cfg.LengthPrefix != 0 && !validFieldSize(cfg.LengthPrefix) {
+		panic(fmt.Sprintf("NewFrameGenerator: invalid length prefix size %d", cfg.LengthPrefix))
+	}
+	if cfg.MinPayload < 0 || cfg.MinPayload > cfg.MaxPayload {
+		panic(fmt.Sprintf("NewFrameGenerator: invalid payload range [%d, %d]", cfg.MinPayload, cfg.MaxPayload))
+	}
+	if cfg.LengthPrefix != 0 && uint64(cfg.MaxPayload) > maxUint(cfg.LengthPrefix) {
+		panic(fmt.Sprintf("NewFrameGenerator: %d byte length prefix cannot hold MaxPayload %d", cfg.LengthPrefix, cfg.MaxPayload))
+	}
+	if cfg.ByteOrder == nil {
+		cfg.ByteOrder = binary.BigEndian
+	}
+	return &FrameGenerator{r: r, cfg: cfg}
+}
+
+// returns the next frame
+func (g *FrameGenerator) Next() Frame {
+	payload := g.r.Bytes(g.r.Int(g.cfg.MinPayload, g.cfg.MaxPayload))
+	m := NotMalformed
+	if g.cfg.MalformedPercent > 0 && g.r.Int(0, 99) < g.cfg.MalformedPercent {
+		m = g.pickMalformation(len(payload))
+	}
+
+	var invalidFields []int
+	if m == InvalidField {
+		for i, f := range g.cfg.Header {
+			if hasInvalidValue(f) {
+				invalidFields = append(invalidFields, i)
+			}
+		}
+	}
+	invalidField := -1
+	if len(invalidFields) > 0 {
+		invalidField = invalidFields[g.r.Int(0, len(invalidFields)-1)]
+	}
+
+	var data []byte
+	for i, f := range g.cfg.Header {
+		data = g.appendUint(data, f.Size, g.fieldValue(f, i == invalidField))
+	}
+	if g.cfg.LengthPrefix != 0 {
+		length := uint64(len(payload))
+		switch m {
+		case LengthTooLarge:
+			extra := uint64(g.r.Int(1, 255))
+			if max := maxUint(g.cfg.LengthPrefix); length+extra > max {
+				extra = max - length
+			}
+			length += extra
+		case LengthTooSmall:
+			length -= uint64(g.r.Int(1, len(payload)))
+		case LengthOverflow:
+			length = maxUint(g.cfg.LengthPrefix)
+		}
+		data = g.appendUint(data, g.cfg.LengthPrefix, length)
+	}
+	data = append(data, payload...)
+
+	switch m {
+	case Truncated:
+		data = data[:g.r.Int(0, len(data)-1)]
+	case TrailingGarbage:
+		data = append(data, g.r.Bytes(g.r.Int(1, 64))...)
+	}
+	return Frame{Data: data, Malformation: m}
+}
+
+// returns a malformation applicable to a frame with a payload of payloadLen bytes
+func (g *FrameGenerator) pickMalformation(payloadLen int) Malformation {
+	candidates := []Malformation{TrailingGarbage}
+	if payloadLen > 0 || g.cfg.LengthPrefix != 0 || len(g.cfg.Header) > 0 {
+		candidates = append(candidates, Truncated)
+	}
+	if g.cfg.LengthPrefix != 0 {
+		if max := maxUint(g.cfg.LengthPrefix); uint64(payloadLen) < max {
+			candidates = append(candidates, LengthTooLarge, LengthOverflow)
+		}
+		if payloadLen > 0 {
+			candidates = append(candidates, LengthTooSmall)
+		}
+	}
+	for _, f := range g.cfg.Header {
+		if hasInvalidValue(f) {
+			candidates = append(candidates, InvalidField)
+			break
+		}
+	}
+	return candidates[g.r.Int(0, len(candidates)-1)]
+}
+
+func (g *FrameGenerator) fieldValue(f FrameField, invalid bool) uint64 {
+	if !invalid {
+		if len(f.Values) > 0 {
+			return f.Values[g.r.Int(0, len(f.Values)-1)]
+		}
+		return g.randomUint(f.Size)
+	}
+	for {
+		v := g.randomUint(f.Size)
+		if !containsUint(f.Values, v) {
+			return v
+		}
+	}
+}
+
+func (g *FrameGenerator) randomUint(size int) uint64 {
+	b := make([]byte, 8)
+	copy(b, g.r.Bytes(size))
+	return binary.LittleEndian.Uint64(b)
+}
+
+func (g *FrameGenerator) appendUint(b []byte, size int, v uint64) []byte {
+	buf := make([]byte, 8)
+	switch size {
+	case 1:
+		buf[0] = byte(v)
+	case 2:
+		g.cfg.ByteOrder.PutUint16(buf, uint16(v))
+	case 4:
+		g.cfg.ByteOrder.PutUint32(buf, uint32(v))
+	case 8:
+		g.cfg.ByteOrder.PutUint64(buf, v)
+	}
+	return append(b, buf[:size]...)
+}
+
+// reports whether some value of the field's size is not among its Values
+func hasInvalidValue(f FrameField) bool {
+	if len(f.Values) == 0 {
+		return false
+	}
+	return f.Size == 8 || uint64(len(f.Values)) < uint64(1)<<(8*f.Size)
+}
+
+func validFieldSize(size int) bool {
+	return size == 1 || size == 2 || size == 4 || size == 8
+}
+
+func maxUint(size int) uint64 {
+	return ^uint64(0) >> (64 - 8*size)
+}
+
+func containsUint(values []uint64, v uint64) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package random
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"testing"
+)
+
+func TestFrameGeneratorMalformations(t *testing.T) {
+	cfg := FrameConfig{
+		Header: []FrameField{
+			{Name: "type", Size: 1, Values: []uint64{1, 2, 3}},
+			{Name: "id", Size: 4},
+		},
+		LengthPrefix:     2,
+		ByteOrder:        binary.LittleEndian,
+		MinPayload:       0,
+		MaxPayload:       100,
+		MalformedPercent: 50,
+	}
+	const headerLen = 1 + 4 + 2
+	const trials = 10000
+	g := NewFrameGenerator(NewSFRand(), cfg)
+	counts := map[Malformation]int{}
+	for i := 0; i < trials; i++ {
+		f := g.Next()
+		counts[f.Malformation]++
+		if f.Malformation == Truncated {
+			if len(f.Data) >= headerLen && len(f.Data)-headerLen >= int(binary.LittleEndian.Uint16(f.Data[5:])) {
+				t.Fatalf("Truncated frame %x is complete", f.Data)
+			}
+			continue
+		}
+		if len(f.Data) < headerLen {
+			t.Fatalf("%v frame %x is shorter than its header", f.Malformation, f.Data)
+		}
+		validType := slices.Contains(cfg.Header[0].Values, uint64(f.Data[0]))
+		if validType == (f.Malformation == InvalidField) {
+			t.Fatalf("%v frame %x has type %d", f.Malformation, f.Data, f.Data[0])
+		}
+		declared, actual := int(binary.LittleEndian.Uint16(f.Data[5:])), len(f.Data)-headerLen
+		var ok bool
+		switch f.Malformation {
+		case NotMalformed, InvalidField:
+			ok = declared == actual && actual <= cfg.MaxPayload
+		case LengthTooLarge:
+			ok = declared > actual
+		case LengthTooSmall:
+			ok = declared < actual
+		case LengthOverflow:
+			ok = declared == 0xffff
+		case TrailingGarbage:
+			ok = actual > declared
+		}
+		if !ok {
+			t.Fatalf("%v frame %x declares %d payload bytes and has %d", f.Malformation, f.Data, declared, actual)
+		}
+	}
+	checkBinomial(t, "well-formed frames", counts[NotMalformed], trials, 0.5)
+	for m := LengthTooLarge; m <= TrailingGarbage; m++ {
+		if counts[m] == 0 {
+			t.Errorf("no %v frame in %d", m, trials)
+		}
+	}
+}
+
+func TestFrameGeneratorWellFormed(t *testing.T) {
+	g := NewFrameGenerator(NewSFRand(), FrameConfig{
+		Header:     []FrameField{{Name: "magic", Size: 2, Values: []uint64{0xcafe}}},
+		MinPayload: 3,
+		MaxPayload: 3,
+	})
+	for i := 0; i < 100; i++ {
+		f := g.Next()
+		if f.Malformation != NotMalformed || len(f.Data) != 5 || !bytes.Equal(f.Data[:2], []byte{0xca, 0xfe}) {
+			t.Fatalf("Next() = %x, %v, want big-endian magic and 3 payload bytes", f.Data, f.Malformation)
+		}
+	}
+}
+
+func TestFrameGeneratorDeterministic(t *testing.T) {
+	cfg := FrameConfig{LengthPrefix: 1, MaxPayload: 20, MalformedPercent: 30}
+	a := NewFrameGenerator(NewSeededSFRand(7), cfg)
+	b := NewFrameGenerator(NewSeededSFRand(7), cfg)
+	for i := 0; i < 100; i++ {
+		if fa, fb := a.Next(), b.Next(); !bytes.Equal(fa.Data, fb.Data) || fa.Malformation != fb.Malformation {
+			t.Fatalf("frame %d differs for the same seed: %x %v, %x %v", i, fa.Data, fa.Malformation, fb.Data, fb.Malformation)
+		}
+	}
+}
+
+func TestNewFrameGeneratorPanics(t *testing.T) {
+	for name, cfg := range map[string]FrameConfig{
+		"field size":       {Header: []FrameField{{Name: "x", Size: 3}}},
+		"prefix size":      {LengthPrefix: 5},
+		"negative min":     {MinPayload: -1, MaxPayload: 1},
+		"min above max":    {MinPayload: 2, MaxPayload: 1},
+		"prefix too small": {LengthPrefix: 1, MaxPayload: 256},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: NewFrameGenerator did not panic", name)
+				}
+			}()
+			NewFrameGenerator(NewSFRand(), cfg)
+		}()
+	}
+}
+
+func TestMalformationString(t *testing.T) {
+	if got := LengthOverflow.String(); got != "LengthOverflow" {
+		t.Errorf("LengthOverflow.String() = %q", got)
+	}
+	if got := Malformation(42).String(); got != "Malformation(42)" {
+		t.Errorf("Malformation(42).String() = %q", got)
+	}
+}
@@ -0,0 +1,50 @@
+package random
+
+import "math"
+
+// returns pseudo-random coordinates in degrees, uniformly distributed over the surface of the earth taken as a
+// sphere: latitudes are weighted by cos(lat), so unlike with a uniform latitude the poles are not oversampled
+func (r *randomizer) LatLng() (lat float64, lng float64) {
+	return r.LatLngInBounds(-90, 90, -180, 180)
+}
+
+// same as LatLng but within a bounding box, which crosses the antimeridian if minLng > maxLng, e.g. from 170
+// to -170. It panics if the latitudes are not in [-90,90] with minLat <= maxLat or the longitudes are not in
+// [-180,180].
+func (r *randomizer) LatLngInBounds(minLat float64, maxLat float64, minLng float64, maxLng float64) (lat float64, lng float64) {
+	if !(-90 <= minLat && minLat <= maxLat && maxLat <= 90) || !(-180 <= minLng && minLng <= 180 && -180 <= maxLng && maxLng <= 180) {
+		panic("invalid argument to LatLngInBounds")
+	}
+	// area on a sphere is uniform in the sine of the latitude
+	lo, hi := math.Sin(minLat*math.Pi/180), math.Sin(maxLat*math.Pi/180)
+	lat = math.Max(minLat, math.Min(maxLat, math.Asin(r.Float64Range(lo, hi))*180/math.Pi))
+	if minLng <= maxLng {
+		return lat, r.Float64Range(minLng, maxLng)
+	}
+	lng = r.Float64Range(minLng, maxLng+360)
+	if lng > 180 {
+		lng -= 360
+	}
+	return lat, lng
+}
+
+// returns pseudo-random point uniformly distributed over the disc of the given radius around (cx, cy). The
+// distance from the center is drawn as radius*sqrt(u), as a uniform one would crowd points near the center.
+// It panics if radius is negative or not finite.
+func (r *randomizer) PointInCircle(cx float64, cy float64, radius float64) (x float64, y float64) {
+	if !(radius >= 0) || math.IsInf(radius, 0) {
+		panic("invalid argument to PointInCircle: radius must be finite and not negative")
+	}
+	d := radius * math.Sqrt(r.Float64())
+	sin, cos := math.Sincos(2 * math.Pi * r.Float64())
+	return cx + d*cos, cy + d*sin
+}
+
+// returns pseudo-random point uniformly distributed over the surface of the unit sphere, drawing z uniformly
+// from [-1,1] as by Archimedes' hat-box theorem every slice of equal height has equal area
+func (r *randomizer) PointOnSphere() (x float64, y float64, z float64) {
+	z = 2*r.Float64() - 1
+	d := math.Sqrt(1 - z*z)
+	sin, cos := math.Sincos(2 * math.Pi * r.Float64())
+	return d * cos, d * sin, z
+}
@@ -0,0 +1,115 @@
+package random
+
+import "strings"
+
+// visually confusable replacements, mostly Cyrillic and Greek letters plus digit/letter swaps
+var homoglyphs = map[rune][]string{
+	'a': {"а", "ɑ", "α"},
+	'c': {"с", "ϲ"},
+	'd': {"ԁ"},
+	'e': {"е"},
+	'h': {"һ"},
+	'i': {"і", "ı", "1", "l"},
+	'j': {"ј"},
+	'k': {"κ"},
+	'l': {"1", "I", "ӏ"},
+	'm': {"rn"},
+	'n': {"ո"},
+	'o': {"о", "ο", "0"},
+	'p': {"р", "ρ"},
+	'q': {"ԛ"},
+	's': {"ѕ"},
+	'u': {"υ", "ս"},
+	'v': {"ν"},
+	'w': {"ԝ", "vv"},
+	'x': {"х"},
+	'y': {"у"},
+	'A': {"А", "Α"},
+	'B': {"В", "Β"},
+	'C': {"С"},
+	'E': {"Е", "Ε"},
+	'H': {"Н", "Η"},
+	'I': {"І", "Ι", "l", "1"},
+	'J': {"Ј"},
+	'K': {"К", "Κ"},
+	'M': {"М", "Μ"},
+	'N': {"Ν"},
+	'O': {"О", "Ο", "0"},
+	'P': {"Р", "Ρ"},
+	'S': {"Ѕ"},
+	'T': {"Т", "Τ"},
+	'X': {"Х", "Χ"},
+	'Y': {"Υ", "Ү"},
+	'Z': {"Ζ"},
+	'0': {"O", "o", "О"},
+	'1': {"l", "I"},
+}
+
+// zero width space, zero width non-joiner, zero width joiner and zero width no-break space, the byte order mark
+var zeroWidthChars = []string{"\u200b", "\u200c", "\u200d", "\ufeff"}
+
+// returns up to n distinct variants of s that look like s but differ from it, produced by swapping
+// characters for confusable ones (e.g. Cyrillic а for Latin a) and inserting zero-width characters.
+// Fewer than n variants are returned if s does not allow that many, and nil if n <= 0.
+func (r *randomizer) Homoglyphs(s string, n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	runes := []rune(s)
+	var candidates []int
+	for i, c := range runes {
+		if _, ok := homoglyphs[c]; ok {
+			candidates = append(candidates, i)
+		}
+	}
+
+	seen := map[string]bool{s: true}
+	out := make([]string, 0, n)
+	for attempt := 0; len(out) < n && attempt < n*10; attempt++ {
+		v := r.homoglyphVariant(runes, candidates)
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func (r *randomizer) homoglyphVariant(runes []rune, candidates []int) string {
+	replace := make(map[int]bool, len(candidates))
+	for _, i := range candidates {
+		if r.Int(0, 2) == 0 {
+			replace[i] = true
+		}
+	}
+	// insertion point of a zero-width character, -1 for none
+	insertAt := -1
+	if r.Int(0, 3) == 0 {
+		insertAt = r.Int(0, len(runes))
+	}
+	// make sure the variant differs from the original
+	if len(replace) == 0 && insertAt < 0 {
+		if len(candidates) > 0 && r.Int(0, 3) != 0 {
+			replace[candidates[r.Int(0, len(candidates)-1)]] = true
+		} else {
+			insertAt = r.Int(0, len(runes))
+		}
+	}
+
+	var sb strings.Builder
+	for i, c := range runes {
+		if i == insertAt {
+			sb.WriteString(zeroWidthChars[r.Int(0, len(zeroWidthChars)-1)])
+		}
+		if replace[i] {
+			alts := homoglyphs[c]
+			sb.WriteString(alts[r.Int(0, len(alts)-1)])
+		} else {
+			sb.WriteRune(c)
+		}
+	}
+	if insertAt == len(runes) {
+		sb.WriteString(zeroWidthChars[r.Int(0, len(zeroWidthChars)-1)])
+	}
+	return sb.String()
+}
@@ -0,0 +1,23 @@
+package random
+
+import "testing"
+
+func TestHomoglyphs(t *testing.T) {
+	r := NewSFRand()
+	for _, n := range []int{-1, 0} {
+		if got := r.Homoglyphs("paypal", n); got != nil {
+			t.Errorf("Homoglyphs(%d) = %q, want nil", n, got)
+		}
+	}
+	got := r.Homoglyphs("paypal", 5)
+	if len(got) != 5 {
+		t.Fatalf("Homoglyphs(5) returned %d variants", len(got))
+	}
+	seen := map[string]bool{"paypal": true}
+	for _, v := range got {
+		if seen[v] {
+			t.Errorf("Homoglyphs(5) returned %q twice or unchanged", v)
+		}
+		seen[v] = true
+	}
+}
@@ -0,0 +1,216 @@
+package random
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// value with an integer weight relative to the other values of its table
+type weighted[T any] struct {
+	value  T
+	weight int
+}
+
+// returns value of items picked with probability proportional to its weight
+func pickWeighted[T any](r SFRand, items []weighted[T]) T {
+	total := 0
+	for _, it := range items {
+		total += it.weight
+	}
+	n := r.Int(0, total-1)
+	for _, it := range items {
+		if n < it.weight {
+			return it.value
+		}
+		n -= it.weight
+	}
+	return items[len(items)-1].value
+}
+
+// weights loosely follow the mix of requests seen by public web servers
+var httpMethods = []weighted[string]{
+	{http.MethodGet, 700},
+	{http.MethodPost, 180},
+	{http.MethodPut, 40},
+	{http.MethodPatch, 30},
+	{http.MethodDelete, 25},
+	{http.MethodHead, 15},
+	{http.MethodOptions, 9},
+	{http.MethodTrace, 1},
+}
+
+// status codes per class, indexed by class
+var httpStatuses = [6][]weighted[int]{
+	1: {
+		{http.StatusContinue, 5},
+		{http.StatusSwitchingProtocols, 4},
+		{http.StatusEarlyHints, 1},
+	},
+	2: {
+		{http.StatusOK, 850},
+		{http.StatusCreated, 60},
+		{http.StatusAccepted, 10},
+		{http.StatusNoContent, 70},
+		{http.StatusPartialContent, 10},
+	},
+	3: {
+		{http.StatusMovedPermanently, 300},
+		{http.StatusFound, 350},
+		{http.StatusNotModified, 300},
+		{http.StatusTemporaryRedirect, 30},
+		{http.StatusPermanentRedirect, 20},
+	},
+	4: {
+		{http.StatusBadRequest, 200},
+		{http.StatusUnauthorized, 150},
+		{http.StatusForbidden, 150},
+		{http.StatusNotFound, 400},
+		{http.StatusMethodNotAllowed, 20},
+		{http.StatusConflict, 30},
+		{http.StatusUnprocessableEntity, 20},
+		{http.StatusTooManyRequests, 30},
+	},
+	5: {
+		{http.StatusInternalServerError, 500},
+		{http.StatusBadGateway, 200},
+		{http.StatusServiceUnavailable, 200},
+		{http.StatusGatewayTimeout, 100},
+	},
+}
+
+// share of each class when HTTPStatus is called with class 0
+var httpStatusClasses = []weighted[int]{
+	{1, 1},
+	{2, 850},
+	{3, 60},
+	{4, 70},
+	{5, 19},
+}
+
+// header whose values HTTPHeaderValue generates
+type HeaderKind int
+
+const (
+	HeaderAccept HeaderKind = iota
+	HeaderAcceptEncoding
+	HeaderAcceptLanguage
+	HeaderCacheControl
+	HeaderContentType
+	HeaderUserAgent
+)
+
+// returns canonical name of the header, e.g. "Accept-Language"
+func (k HeaderKind) String() string {
+	switch k {
+	case HeaderAccept:
+		return "Accept"
+	case HeaderAcceptEncoding:
+		return "Accept-Encoding"
+	case HeaderAcceptLanguage:
+		return "Accept-Language"
+	case HeaderCacheControl:
+		return "Cache-Control"
+	case HeaderContentType:
+		return "Content-Type"
+	case HeaderUserAgent:
+		return "User-Agent"
+	}
+	return fmt.Sprintf("HeaderKind(%d)", int(k))
+}
+
+var headerValues = map[HeaderKind][]weighted[string]{
+	HeaderAccept: {
+		{"*/*", 400},
+		{"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", 300},
+		{"application/json", 200},
+		{"application/json, text/plain, */*", 80},
+		{"image/avif,image/webp,*/*", 20},
+	},
+	HeaderAcceptEncoding: {
+		{"gzip, deflate, br", 500},
+		{"gzip, deflate, br, zstd", 200},
+		{"gzip, deflate", 150},
+		{"gzip", 100},
+		{"identity", 50},
+	},
+	HeaderCacheControl: {
+		{"no-cache", 300},
+		{"max-age=0", 300},
+		{"no-store", 150},
+		{"public, max-age=3600", 150},
+		{"private, max-age=600", 100},
+	},
+	HeaderContentType: {
+		{"application/json", 500},
+		{"application/x-www-form-urlencoded", 200},
+		{"multipart/form-data; boundary=----WebKitFormBoundary7MA4YWxkTrZu0gW", 100},
+		{"text/plain; charset=utf-8", 100},
+		{"application/xml", 50},
+		{"application/octet-stream", 50},
+	},
+}
+
+// languages for Accept-Language
+var acceptLanguages = []weighted[string]{
+	{"en-US", 400},
+	{"en-GB", 80},
+	{"de-DE", 60},
+	{"fr-FR", 60},
+	{"es-ES", 50},
+	{"pt-BR", 50},
+	{"ja-JP", 40},
+	{"zh-CN", 50},
+	{"ru-RU", 30},
+	{"it-IT", 30},
+}
+
+// returns pseudo-random HTTP method drawn with r, weighted toward GET and POST
+func HTTPMethod(r SFRand) string {
+	return pickWeighted(r, httpMethods)
+}
+
+// returns pseudo-random HTTP status code drawn with r of the given class (1 for 1xx up to 5 for 5xx), weighted
+// toward the common codes of the class. Class 0 picks from all classes, weighted toward 2xx. It panics for other
+// classes.
+func HTTPStatus(r SFRand, class int) int {
+	if class < 0 || class >= len(httpStatuses) {
+		panic(fmt.Sprintf("HTTPStatus: invalid status class %d", class))
+	}
+	if class == 0 {
+		class = pickWeighted(r, httpStatusClasses)
+	}
+	return pickWeighted(r, httpStatuses[class])
+}
+
+// returns plausible pseudo-random value drawn with r for the header of the given kind. It panics for unknown kinds.
+func HTTPHeaderValue(r SFRand, kind HeaderKind) string {
+	switch kind {
+	case HeaderAcceptLanguage:
+		return acceptLanguage(r)
+	case HeaderUserAgent:
+		return r.UserAgent()
+	}
+	values, ok := headerValues[kind]
+	if !ok {
+		panic(fmt.Sprintf("HTTPHeaderValue: unknown header kind %d", int(kind)))
+	}
+	return pickWeighted(r, values)
+}
+
+// returns Accept-Language of one to three distinct languages with decreasing quality values,
+// e.g. "de-DE,en-US;q=0.9,fr-FR;q=0.8"
+func acceptLanguage(r SFRand) string {
+	out := pickWeighted(r, acceptLanguages)
+	seen := map[string]bool{out: true}
+	q := 9
+	for i := r.Int(0, 2); i > 0; i-- {
+		next := pickWeighted(r, acceptLanguages)
+		if seen[next] {
+			continue
+		}
+		seen[next] = true
+		out += fmt.Sprintf(",%s;q=0.%d", next, q)
+		q--
+	}
+	return out
+}
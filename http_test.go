@@ -0,0 +1,30 @@
+package random
+
+import "testing"
+
+func TestHTTPHeaderValueUserAgent(t *testing.T) {
+	a, b := NewSeededSFRand(7), NewSeededSFRand(7)
+	for i := 0; i < 50; i++ {
+		if got, want := HTTPHeaderValue(a, HeaderUserAgent), b.UserAgent(); got != want {
+			t.Fatalf("HTTPHeaderValue(HeaderUserAgent) = %q, want UserAgent() = %q", got, want)
+		}
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	r := NewSFRand()
+	for class := 0; class <= 5; class++ {
+		for i := 0; i < 100; i++ {
+			code := HTTPStatus(r, class)
+			if code < 100 || code > 599 || class != 0 && code/100 != class {
+				t.Fatalf("HTTPStatus(%d) = %d", class, code)
+			}
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("HTTPStatus(6) did not panic")
+		}
+	}()
+	HTTPStatus(r, 6)
+}
@@ -0,0 +1,121 @@
+package random
+
+import (
+	"strconv"
+	"strings"
+)
+
+// number formatting conventions of a locale
+type NumberFormat struct {
+	Locale  string
+	Decimal string
+	// group separator, empty for no grouping
+	Group string
+	// Indian style grouping, the first group has 3 digits and all following groups 2
+	IndianGrouping bool
+	// native digits 0-9, empty for ASCII digits
+	Digits string
+}
+
+var numberFormats = []NumberFormat{
+	{Locale: "en-US", Decimal: ".", Group: ","},
+	{Locale: "en-GB", Decimal: ".", Group: ","},
+	{Locale: "de-DE", Decimal: ",", Group: "."},
+	{Locale: "de-CH", Decimal: ".", Group: "’"},
+	{Locale: "fr-FR", Decimal: ",", Group: " "},
+	{Locale: "es-ES", Decimal: ",", Group: "."},
+	{Locale: "it-IT", Decimal: ",", Group: "."},
+	{Locale: "pt-BR", Decimal: ",", Group: "."},
+	{Locale: "ru-RU", Decimal: ",", Group: " "},
+	{Locale: "sv-SE", Decimal: ",", Group: " "},
+	{Locale: "ja-JP", Decimal: ".", Group: ","},
+	{Locale: "en-IN", Decimal: ".", Group: ",", IndianGrouping: true},
+	{Locale: "hi-IN", Decimal: ".", Group: ",", IndianGrouping: true, Digits: "०१२३४५६७८९"},
+	{Locale: "ar-EG", Decimal: "٫", Group: "٬", Digits: "٠١٢٣٤٥٦٧٨٩"},
+	{Locale: "fa-IR", Decimal: "٫", Group: "٬", Digits: "۰۱۲۳۴۵۶۷۸۹"},
+}
+
+// number rendered in a locale's format
+type FormattedNumber struct {
+	// the number as formatted for Format, e.g. "1.234.567,89"
+	Text string
+	// the same number with ASCII digits, '.' as decimal separator and no grouping, e.g. "1234567.89"
+	Canonical string
+	// the number as float64, parsed from Canonical
+	Value  float64
+	Format NumberFormat
+}
+
+// returns decimal number drawn with r and formatted in the conventions of a locale also drawn with r, for
+// fuzzing locale-aware parsers. Grouping separators are omitted from a quarter of the numbers, as users often do.
+func LocaleNumber(r SFRand) FormattedNumber {
+	f := numberFormats[r.Int(0, len(numberFormats)-1)]
+	if r.Int(0, 3) == 0 {
+		f.Group = ""
+	}
+
+	intPart := strconv.Itoa(r.Int(0, 999_999_999) / pow10(r.Int(0, 8)))
+	fracPart := r.String(r.Int(0, 3), GetNumericPool())
+	negative := r.Int(0, 4) == 0
+
+	canonical := intPart
+	if fracPart != "" {
+		canonical += "." + fracPart
+	}
+	if negative {
+		canonical = "-" + canonical
+	}
+
+	var sb strings.Builder
+	if negative {
+		sb.WriteByte('-')
+	}
+	sb.WriteString(groupDigits(intPart, f))
+	if fracPart != "" {
+		sb.WriteString(f.Decimal)
+		sb.WriteString(localDigits(fracPart, f))
+	}
+
+	value, _ := strconv.ParseFloat(canonical, 64)
+	return FormattedNumber{Text: sb.String(), Canonical: canonical, Value: value, Format: f}
+}
+
+// returns ASCII digits s with the locale's grouping and digits applied
+func groupDigits(s string, f NumberFormat) string {
+	if f.Group == "" {
+		return localDigits(s, f)
+	}
+	var groups []string
+	size := 3
+	for len(s) > size {
+		groups = append([]string{s[len(s)-size:]}, groups...)
+		s = s[:len(s)-size]
+		if f.IndianGrouping {
+			size = 2
+		}
+	}
+	groups = append([]string{s}, groups...)
+	return localDigits(strings.Join(groups, f.Group), f)
+}
+
+// returns s with ASCII digits replaced by the locale's digits
+func localDigits(s string, f NumberFormat) string {
+	if f.Digits == "" {
+		return s
+	}
+	digits := []rune(f.Digits)
+	return strings.Map(func(c rune) rune {
+		if c >= '0' && c <= '9' {
+			return digits[c-'0']
+		}
+		return c
+	}, s)
+}
+
+func pow10(n int) int {
+	p := 1
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
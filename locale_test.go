@@ -0,0 +1,61 @@
+package random
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGroupDigits(t *testing.T) {
+	formats := map[string]NumberFormat{}
+	for _, f := range numberFormats {
+		formats[f.Locale] = f
+	}
+	tests := []struct {
+		locale, in, want string
+	}{
+		{"en-US", "1234567", "1,234,567"},
+		{"en-US", "123", "123"},
+		{"de-DE", "1234", "1.234"},
+		{"fr-FR", "1000000", "1\u202f000\u202f000"},
+		{"en-IN", "1234567", "12,34,567"},
+		{"en-IN", "12345", "12,345"},
+		{"hi-IN", "1234567", "१२,३४,५६७"},
+		{"ar-EG", "1234", "١٬٢٣٤"},
+	}
+	for _, tt := range tests {
+		if got := groupDigits(tt.in, formats[tt.locale]); got != tt.want {
+			t.Errorf("groupDigits(%q) for %s = %q, want %q", tt.in, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestLocaleNumber(t *testing.T) {
+	r := NewSFRand()
+	for i := 0; i < 1000; i++ {
+		n := LocaleNumber(r)
+		if v, err := strconv.ParseFloat(n.Canonical, 64); err != nil || v != n.Value {
+			t.Fatalf("LocaleNumber() = %+v, Canonical does not parse to Value", n)
+		}
+		// undoing the locale's formatting gives the canonical form
+		text := n.Text
+		if n.Format.Group != "" {
+			text = strings.ReplaceAll(text, n.Format.Group, "")
+		}
+		text = strings.Replace(text, n.Format.Decimal, ".", 1)
+		if n.Format.Digits != "" {
+			digits := []rune(n.Format.Digits)
+			text = strings.Map(func(c rune) rune {
+				for d, native := range digits {
+					if c == native {
+						return '0' + rune(d)
+					}
+				}
+				return c
+			}, text)
+		}
+		if text != n.Canonical {
+			t.Fatalf("LocaleNumber() = %+v, Text does not match Canonical", n)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package random
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+	"strings"
+)
+
+// receives warnings such as fallbacks to math/rand, see WithLogger. *log.Logger implements it.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+// returns Logger passing messages to l at warning level
+func SlogLogger(l *slog.Logger) Logger {
+	return slogLogger{l: l}
+}
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+func (s slogLogger) Printf(format string, v ...any) {
+	s.l.Warn(strings.TrimSuffix(fmt.Sprintf(format, v...), "\n"))
+}
+
+// writes a warning to the configured Logger, or the standard logger if there is none
+func (r *randomizer) logf(format string, v ...any) {
+	if r.logger == nil {
+		log.Printf(format, v...)
+		return
+	}
+	r.logger.Printf(format, v...)
+}
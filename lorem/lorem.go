@@ -0,0 +1,98 @@
+// Package lorem generates lorem ipsum filler text for fixtures and UI previews. Text is drawn from the
+// SFRand passed to New, so it is reproducible when that is seeded, e.g. with random.NewSeededSFRand.
+package lorem
+
+import (
+	"strings"
+
+	"github.com/h4ckitt/random"
+)
+
+// Generator produces lorem ipsum text. It is safe for concurrent use if its SFRand is.
+type Generator struct {
+	r random.SFRand
+}
+
+// returns Generator drawing from r
+func New(r random.SFRand) *Generator {
+	return &Generator{r: r}
+}
+
+// returns n space-separated lowercase words, without punctuation
+func (g *Generator) Words(n int) string {
+	words := make([]string, n)
+	for i := range words {
+		words[i] = g.word()
+	}
+	return strings.Join(words, " ")
+}
+
+// returns sentence of 6 to 16 words starting with a capital letter and ending with a period, with a comma
+// after one of the words in about half of them
+func (g *Generator) Sentence() string {
+	n := g.r.Int(6, 16)
+	comma := -1
+	if g.r.Bool() {
+		comma = g.r.Int(1, n-3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		w := g.word()
+		if i == 0 {
+			w = strings.ToUpper(w[:1]) + w[1:]
+		} else {
+			b.WriteByte(' ')
+		}
+		b.WriteString(w)
+		if i == comma {
+			b.WriteByte(',')
+		}
+	}
+	b.WriteByte('.')
+	return b.String()
+}
+
+// returns n space-separated sentences
+func (g *Generator) Sentences(n int) string {
+	sentences := make([]string, n)
+	for i := range sentences {
+		sentences[i] = g.Sentence()
+	}
+	return strings.Join(sentences, " ")
+}
+
+// returns n paragraphs of 3 to 7 sentences each, separated by blank lines
+func (g *Generator) Paragraphs(n int) string {
+	paragraphs := make([]string, n)
+	for i := range paragraphs {
+		paragraphs[i] = g.Sentences(g.r.Int(3, 7))
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+func (g *Generator) word() string {
+	return words[g.r.Int(0, len(words)-1)]
+}
+
+// words of the classic lorem ipsum passage
+var words = []string{
+	"a", "ac", "accumsan", "ad", "adipiscing", "aenean", "aliquam", "aliquet", "amet", "ante",
+	"aptent", "arcu", "at", "auctor", "augue", "bibendum", "blandit", "class", "commodo", "condimentum",
+	"congue", "consectetur", "consequat", "conubia", "convallis", "cras", "cubilia", "curabitur", "curae", "cursus",
+	"dapibus", "diam", "dictum", "dictumst", "dignissim", "dis", "dolor", "donec", "dui", "duis",
+	"efficitur", "egestas", "eget", "eleifend", "elementum", "elit", "enim", "erat", "eros", "est",
+	"et", "etiam", "eu", "euismod", "ex", "facilisi", "facilisis", "fames", "faucibus", "felis",
+	"fermentum", "feugiat", "finibus", "fringilla", "fusce", "gravida", "habitant", "habitasse", "hac", "hendrerit",
+	"himenaeos", "iaculis", "id", "imperdiet", "in", "inceptos", "integer", "interdum", "ipsum", "justo",
+	"lacinia", "lacus", "laoreet", "lectus", "leo", "libero", "ligula", "litora", "lobortis", "lorem",
+	"luctus", "maecenas", "magna", "magnis", "malesuada", "massa", "mattis", "mauris", "maximus", "metus",
+	"mi", "molestie", "mollis", "montes", "morbi", "mus", "nam", "nascetur", "natoque", "nec",
+	"neque", "netus", "nibh", "nisi", "nisl", "non", "nostra", "nulla", "nullam", "nunc",
+	"odio", "orci", "ornare", "parturient", "pellentesque", "penatibus", "per", "pharetra", "phasellus", "placerat",
+	"platea", "porta", "porttitor", "posuere", "potenti", "praesent", "pretium", "primis", "proin", "pulvinar",
+	"purus", "quam", "quis", "quisque", "rhoncus", "ridiculus", "risus", "rutrum", "sagittis", "sapien",
+	"scelerisque", "sed", "sem", "semper", "senectus", "sit", "sociosqu", "sodales", "sollicitudin", "suscipit",
+	"suspendisse", "taciti", "tellus", "tempor", "tempus", "tincidunt", "torquent", "tortor", "tristique", "turpis",
+	"ullamcorper", "ultrices", "ultricies", "urna", "ut", "varius", "vehicula", "vel", "velit", "venenatis",
+	"vestibulum", "vitae", "vivamus", "viverra", "volutpat", "vulputate",
+}
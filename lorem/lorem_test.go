@@ -0,0 +1,106 @@
+package lorem
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/h4ckitt/random"
+)
+
+func TestWords(t *testing.T) {
+	g := New(random.NewSFRand())
+	for _, n := range []int{0, 1, 50} {
+		s := g.Words(n)
+		got := strings.Fields(s)
+		if len(got) != n {
+			t.Errorf("Words(%d) = %q has %d words", n, s, len(got))
+		}
+		for _, w := range got {
+			if !slices.Contains(words, w) {
+				t.Errorf("Words(%d) returned %q, not a lorem ipsum word", n, w)
+			}
+		}
+	}
+}
+
+// checks the shape of a sentence and returns its number of words
+func checkSentence(t *testing.T, s string) int {
+	t.Helper()
+	if !strings.HasSuffix(s, ".") || !unicode.IsUpper(rune(s[0])) {
+		t.Fatalf("sentence %q does not start with a capital letter and end with a period", s)
+	}
+	fields := strings.Fields(strings.TrimSuffix(s, "."))
+	commas := 0
+	for i, w := range fields {
+		if strings.HasSuffix(w, ",") {
+			commas++
+			if i == 0 || i > len(fields)-3 {
+				t.Errorf("sentence %q has a comma after word %d of %d", s, i, len(fields))
+			}
+			w = strings.TrimSuffix(w, ",")
+		}
+		if !slices.Contains(words, strings.ToLower(w)) {
+			t.Errorf("sentence %q contains %q, not a lorem ipsum word", s, w)
+		}
+	}
+	if commas > 1 {
+		t.Errorf("sentence %q has %d commas", s, commas)
+	}
+	if len(fields) < 6 || len(fields) > 16 {
+		t.Errorf("sentence %q has %d words, want 6 to 16", s, len(fields))
+	}
+	return len(fields)
+}
+
+func TestSentence(t *testing.T) {
+	g := New(random.NewSFRand())
+	lengths := map[int]bool{}
+	withComma := 0
+	const trials = 2000
+	for i := 0; i < trials; i++ {
+		s := g.Sentence()
+		lengths[checkSentence(t, s)] = true
+		if strings.Contains(s, ",") {
+			withComma++
+		}
+	}
+	if len(lengths) != 11 {
+		t.Errorf("sentences had %d distinct lengths, want all 11 from 6 to 16", len(lengths))
+	}
+	if withComma < trials*4/10 || withComma > trials*6/10 {
+		t.Errorf("%d of %d sentences have a comma, want about half", withComma, trials)
+	}
+}
+
+func TestSentencesParagraphs(t *testing.T) {
+	g := New(random.NewSFRand())
+	if got := g.Sentences(0); got != "" {
+		t.Errorf("Sentences(0) = %q", got)
+	}
+	sentences := strings.SplitAfter(g.Sentences(5), ". ")
+	if len(sentences) != 5 {
+		t.Fatalf("Sentences(5) has %d sentences", len(sentences))
+	}
+	for _, s := range sentences {
+		checkSentence(t, strings.TrimSuffix(s, " "))
+	}
+
+	paragraphs := strings.Split(g.Paragraphs(20), "\n\n")
+	if len(paragraphs) != 20 {
+		t.Fatalf("Paragraphs(20) has %d paragraphs", len(paragraphs))
+	}
+	for _, p := range paragraphs {
+		if n := strings.Count(p, "."); n < 3 || n > 7 {
+			t.Errorf("paragraph %q has %d sentences, want 3 to 7", p, n)
+		}
+	}
+}
+
+func TestReproducible(t *testing.T) {
+	a, b := New(random.NewSeededSFRand(3)), New(random.NewSeededSFRand(3))
+	if pa, pb := a.Paragraphs(3), b.Paragraphs(3); pa != pb {
+		t.Errorf("Paragraphs differ for the same seed:\n%s\n%s", pa, pb)
+	}
+}
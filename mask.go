@@ -0,0 +1,65 @@
+package random
+
+import "strings"
+
+// returns string following mask, where each mask character is replaced by a pseudo-random
+//
+//	A  uppercase letter A-Z
+//	a  lowercase letter a-z
+//	9  digit 0-9
+//	#  alphanumeric character 0-9 a-z A-Z
+//	?  character from GetTokenPool
+//
+// any other character is copied as is, and a backslash copies the character following it,
+// e.g. FromMask("AA-9999-aa") returns "QX-0471-mt" and FromMask(`\A-999`) returns "A-305"
+func (r *randomizer) FromMask(mask string) string {
+	var sb strings.Builder
+	sb.Grow(len(mask))
+	escaped := false
+	for _, c := range mask {
+		if escaped {
+			sb.WriteRune(c)
+			escaped = false
+			continue
+		}
+		switch c {
+		case '\\':
+			escaped = true
+		case 'A':
+			sb.WriteRune(r.Rune(GetAlphabeticUppercasePool()))
+		case 'a':
+			sb.WriteRune(r.Rune(GetAlphabeticLowercasePool()))
+		case '9':
+			sb.WriteRune(r.Rune(GetNumericPool()))
+		case '#':
+			sb.WriteRune(r.Rune(GetAlphaNumericPool()))
+		case '?':
+			sb.WriteRune(r.Rune(GetTokenPool()))
+		default:
+			sb.WriteRune(c)
+		}
+	}
+	// a trailing backslash has nothing to escape and is kept
+	if escaped {
+		sb.WriteRune('\\')
+	}
+	return sb.String()
+}
+
+// returns code following format, where each X is replaced by a pseudo-random rune from pool and any other
+// character is copied as is, e.g. Code("XXXX-XXXX-XXXX", nil) returns "7K2M-QD0W-F9RT". A nil pool uses
+// Crockford's base32 alphabet, whose digits and uppercase letters without I, L, O and U are hard to misread.
+func (r *randomizer) Code(format string, pool []rune) string {
+	if pool == nil {
+		pool = runePool(crockfordAlphabet)
+	}
+	var sb strings.Builder
+	sb.Grow(len(format))
+	for _, c := range format {
+		if c == 'X' {
+			c = r.Rune(pool)
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
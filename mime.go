@@ -0,0 +1,78 @@
+package random
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+var ErrUnknownMIMEType = errors.New("no fixture known for mime type")
+
+// file signature of a mime type. Text types have no signature beyond their prefix and are followed by
+// pseudo-random text instead of bytes.
+type mimeFixture struct {
+	mime  string
+	magic []byte
+	text  bool
+}
+
+var mimeFixtures = []mimeFixture{
+	{mime: "image/png", magic: []byte("\x89PNG\r\n\x1a\n")},
+	{mime: "image/jpeg", magic: []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")},
+	{mime: "image/gif", magic: []byte("GIF89a")},
+	{mime: "image/webp", magic: []byte("RIFF\x00\x00\x00\x00WEBPVP8 ")},
+	{mime: "image/bmp", magic: []byte("BM")},
+	{mime: "image/x-icon", magic: []byte("\x00\x00\x01\x00")},
+	{mime: "application/pdf", magic: []byte("%PDF-1.7\n")},
+	{mime: "application/zip", magic: []byte("PK\x03\x04")},
+	{mime: "application/x-gzip", magic: []byte("\x1f\x8b\x08")},
+	{mime: "application/x-7z-compressed", magic: []byte("7z\xbc\xaf\x27\x1c")},
+	{mime: "application/wasm", magic: []byte("\x00asm\x01\x00\x00\x00")},
+	{mime: "application/ogg", magic: []byte("OggS\x00")},
+	{mime: "audio/mpeg", magic: []byte("ID3")},
+	{mime: "audio/wave", magic: []byte("RIFF\x00\x00\x00\x00WAVEfmt ")},
+	{mime: "video/mp4", magic: []byte("\x00\x00\x00\x18ftypmp42")},
+	{mime: "font/woff", magic: []byte("wOFF")},
+	{mime: "font/woff2", magic: []byte("wOF2")},
+	{mime: "text/html; charset=utf-8", magic: []byte("<!DOCTYPE html>"), text: true},
+	{mime: "text/plain; charset=utf-8", text: true},
+	{mime: "application/json", magic: []byte(`{"data":"`), text: true},
+}
+
+// returns pseudo-random mime type drawn with r out of those FileFixture can produce
+func MIMEType(r SFRand) string {
+	return mimeFixtures[r.Int(0, len(mimeFixtures)-1)].mime
+}
+
+// returns size bytes starting with the file signature ("magic bytes") of mime followed by data drawn with r,
+// for testing upload validation and content sniffing. size is raised to the signature length if smaller.
+// Supported types are those returned by MIMEType, with or without their parameters.
+func FileFixture(r SFRand, mime string, size int) ([]byte, error) {
+	for _, f := range mimeFixtures {
+		if f.mime != mime && mediaType(f.mime) != mime {
+			continue
+		}
+		if size < len(f.magic) {
+			size = len(f.magic)
+		}
+		out := make([]byte, 0, size)
+		out = append(out, f.magic...)
+		if f.text {
+			out = append(out, r.String(size-len(out), GetAlphaNumericPool())...)
+		} else {
+			out = append(out, r.Bytes(size-len(out))...)
+		}
+		// RIFF containers carry the size of the data following the size field
+		if len(out) >= 8 && string(out[:4]) == "RIFF" {
+			binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+		}
+		return out, nil
+	}
+	return nil, ErrUnknownMIMEType
+}
+
+// returns mime without its parameters
+func mediaType(mime string) string {
+	t, _, _ := strings.Cut(mime, ";")
+	return t
+}
@@ -0,0 +1,55 @@
+package random
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func TestFileFixture(t *testing.T) {
+	r := NewSFRand()
+	for _, f := range mimeFixtures {
+		for _, mime := range []string{f.mime, mediaType(f.mime)} {
+			for _, size := range []int{0, 64, 4096} {
+				b, err := FileFixture(r, mime, size)
+				if err != nil {
+					t.Fatalf("FileFixture(%q, %d): %v", mime, size, err)
+				}
+				if want := max(size, len(f.magic)); len(b) != want {
+					t.Errorf("FileFixture(%q, %d) has %d bytes, want %d", mime, size, len(b), want)
+				}
+				if bytes.HasPrefix(f.magic, []byte("RIFF")) {
+					if got := binary.LittleEndian.Uint32(b[4:8]); got != uint32(len(b)-8) {
+						t.Errorf("FileFixture(%q, %d) has RIFF size %d, want %d", mime, size, got, len(b)-8)
+					}
+					continue
+				}
+				if !bytes.HasPrefix(b, f.magic) {
+					t.Errorf("FileFixture(%q, %d) = %q... does not start with its signature", mime, size, b[:len(f.magic)])
+				}
+			}
+		}
+	}
+	if _, err := FileFixture(r, "application/x-unknown", 10); !errors.Is(err, ErrUnknownMIMEType) {
+		t.Errorf("FileFixture(application/x-unknown) error = %v, want ErrUnknownMIMEType", err)
+	}
+}
+
+func TestMIMEType(t *testing.T) {
+	r := NewSFRand()
+	for i := 0; i < 100; i++ {
+		if mime := MIMEType(r); !containsMIME(mime) {
+			t.Fatalf("MIMEType() = %q has no fixture", mime)
+		}
+	}
+}
+
+func containsMIME(mime string) bool {
+	for _, f := range mimeFixtures {
+		if f.mime == mime {
+			return true
+		}
+	}
+	return false
+}
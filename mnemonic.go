@@ -0,0 +1,103 @@
+package random
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//go:embed wordlists/bip39_english.txt
+var bip39English string
+
+var bip39EnglishWords = strings.Fields(bip39English)
+
+var (
+	ErrMnemonicLength   = errors.New("mnemonic must have 12, 15, 18, 21 or 24 words")
+	ErrMnemonicWordlist = errors.New("bip-39 wordlist must have exactly 2048 words")
+	ErrMnemonicWord     = errors.New("mnemonic contains a word that is not in the wordlist")
+	ErrMnemonicChecksum = errors.New("mnemonic checksum does not match")
+)
+
+// returns BIP-39 mnemonic of the given number of words (12, 15, 18, 21 or 24) from the English wordlist.
+// Entropy is taken from the entropy source only: instead of falling back to math/rand an error is returned.
+// Randomizers backed by a seeded generator, see NewSeededSFRand and NewFastInsecure, return ErrInsecureSource.
+func (r *randomizer) Mnemonic(words int) (string, error) {
+	return r.MnemonicWithWordlist(words, bip39EnglishWords, " ")
+}
+
+// same as Mnemonic but draws from a caller supplied 2048 word BIP-39 wordlist, e.g. one of the other
+// official languages. Words are joined with separator, which is "\u3000" for the Japanese list and " " otherwise.
+func (r *randomizer) MnemonicWithWordlist(words int, wordlist []string, separator string) (string, error) {
+	if words < 12 || words > 24 || words%3 != 0 {
+		return "", ErrMnemonicLength
+	}
+	if len(wordlist) != 2048 {
+		return "", ErrMnemonicWordlist
+	}
+	if r.insecure {
+		return "", ErrInsecureSource
+	}
+
+	entropy, err := readBytes(r.src, words/3*4)
+	if err != nil {
+		return "", fmt.Errorf("failed to read entropy for mnemonic: %w", err)
+	}
+	// entropy is followed by len(entropy)/4 checksum bits taken from its sha256 hash
+	checksum := sha256.Sum256(entropy)
+	data := append(entropy, checksum[0])
+
+	out := make([]string, words)
+	for i := range out {
+		idx := 0
+		for bit := i * 11; bit < i*11+11; bit++ {
+			idx = idx<<1 | int(data[bit/8]>>(7-bit%8)&1)
+		}
+		out[i] = wordlist[idx]
+	}
+	return strings.Join(out, separator), nil
+}
+
+// returns nil if mnemonic is a valid BIP-39 mnemonic of the English wordlist: it must have 12, 15, 18, 21 or 24
+// words separated by whitespace, and its checksum bits must match its entropy bits. The error is one of
+// ErrMnemonicLength, ErrMnemonicWord or ErrMnemonicChecksum otherwise.
+func ValidateMnemonic(mnemonic string) error {
+	return ValidateMnemonicWithWordlist(mnemonic, bip39EnglishWords)
+}
+
+// same as ValidateMnemonic but for a caller supplied 2048 word BIP-39 wordlist
+func ValidateMnemonicWithWordlist(mnemonic string, wordlist []string) error {
+	if len(wordlist) != 2048 {
+		return ErrMnemonicWordlist
+	}
+	words := strings.Fields(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return ErrMnemonicLength
+	}
+	index := make(map[string]int, len(wordlist))
+	for i, w := range wordlist {
+		index[w] = i
+	}
+
+	data := make([]byte, len(words)*11/8+1)
+	for i, w := range words {
+		idx, ok := index[w]
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrMnemonicWord, w)
+		}
+		for bit := 0; bit < 11; bit++ {
+			if idx>>(10-bit)&1 == 1 {
+				pos := i*11 + bit
+				data[pos/8] |= 1 << (7 - pos%8)
+			}
+		}
+	}
+	entropy := data[:len(words)/3*4]
+	checksumBits := len(words) / 3
+	checksum := sha256.Sum256(entropy)
+	if data[len(entropy)]>>(8-checksumBits) != checksum[0]>>(8-checksumBits) {
+		return ErrMnemonicChecksum
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package random
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// official test vectors of the reference implementation, https://github.com/trezor/python-mnemonic
+var bip39Vectors = []struct {
+	entropy  string
+	mnemonic string
+}{
+	{"00000000000000000000000000000000", strings.Repeat("abandon ", 11) + "about"},
+	{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f", "legal winner thank year wave sausage worth useful legal winner thank yellow"},
+	{"80808080808080808080808080808080", "letter advice cage absurd amount doctor acoustic avoid letter advice cage above"},
+	{"ffffffffffffffffffffffffffffffff", strings.Repeat("zoo ", 11) + "wrong"},
+	{"9e885d952ad362caeb4efe34a8e91bd2", "ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic"},
+	{
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		strings.Repeat("abandon ", 23) + "art",
+	},
+	{
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful " +
+			"legal winner thank year wave sausage worth title",
+	},
+	{
+		"8080808080808080808080808080808080808080808080808080808080808080",
+		"letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor " +
+			"acoustic avoid letter advice cage absurd amount doctor acoustic bless",
+	},
+	{
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		strings.Repeat("zoo ", 23) + "vote",
+	},
+	{
+		"68a79eaca2324873eacc50cb9c6eca8cc68ea5d936f98787c60c7ebc74e6ce7c",
+		"hamster diagram private dutch cause delay private meat slide toddler razor book happy fancy gospel " +
+			"tennis maple dilemma loan word shrug inflict delay length",
+	},
+}
+
+func TestMnemonicVectors(t *testing.T) {
+	for _, v := range bip39Vectors {
+		entropy, err := hex.DecodeString(v.entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := NewSFRand(WithEntropySource(bytes.NewReader(entropy)), WithFallbackDisabled())
+		got, err := r.Mnemonic(len(entropy) * 3 / 4)
+		if err != nil {
+			t.Fatalf("Mnemonic for %s: %v", v.entropy, err)
+		}
+		if got != v.mnemonic {
+			t.Errorf("Mnemonic for %s = %q, want %q", v.entropy, got, v.mnemonic)
+		}
+		if err := ValidateMnemonic(v.mnemonic); err != nil {
+			t.Errorf("ValidateMnemonic(%q) = %v", v.mnemonic, err)
+		}
+	}
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	for _, tc := range []struct {
+		mnemonic string
+		want     error
+	}{
+		{strings.Repeat("abandon ", 12), ErrMnemonicChecksum},
+		{strings.Repeat("abandon ", 11) + "above", ErrMnemonicChecksum},
+		{strings.Repeat("zoo ", 23) + "wrong", ErrMnemonicChecksum},
+		{"legal winner thank year wave sausage worth useful legal winner thank yellow yellow", ErrMnemonicLength},
+		{strings.Repeat("abandon ", 10) + "about", ErrMnemonicLength},
+		{strings.Repeat("abandon ", 27) + "about", ErrMnemonicLength},
+		{"", ErrMnemonicLength},
+		{strings.Repeat("abandon ", 11) + "abandonn", ErrMnemonicWord},
+	} {
+		if err := ValidateMnemonic(tc.mnemonic); !errors.Is(err, tc.want) {
+			t.Errorf("ValidateMnemonic(%q) = %v, want %v", tc.mnemonic, err, tc.want)
+		}
+	}
+}
+
+func TestMnemonicLength(t *testing.T) {
+	r := NewSFRand()
+	for _, words := range []int{0, 11, 13, 25, 27} {
+		if m, err := r.Mnemonic(words); !errors.Is(err, ErrMnemonicLength) {
+			t.Errorf("Mnemonic(%d) = %q, %v, want ErrMnemonicLength", words, m, err)
+		}
+	}
+	for _, words := range []int{12, 15, 18, 21, 24} {
+		m, err := r.Mnemonic(words)
+		if err != nil {
+			t.Fatalf("Mnemonic(%d): %v", words, err)
+		}
+		if n := len(strings.Fields(m)); n != words {
+			t.Errorf("Mnemonic(%d) has %d words", words, n)
+		}
+		if err := ValidateMnemonic(m); err != nil {
+			t.Errorf("ValidateMnemonic(Mnemonic(%d)) = %v", words, err)
+		}
+	}
+}
+
+func TestMnemonicInsecureSource(t *testing.T) {
+	for name, r := range map[string]SFRand{
+		"seeded": NewSeededSFRand(1),
+		"fast":   NewFastInsecure(),
+	} {
+		if m, err := r.Mnemonic(12); !errors.Is(err, ErrInsecureSource) {
+			t.Errorf("%s: Mnemonic(12) = %q, %v, want ErrInsecureSource", name, m, err)
+		}
+		if m, err := r.MnemonicWithWordlist(24, bip39EnglishWords, " "); !errors.Is(err, ErrInsecureSource) {
+			t.Errorf("%s: MnemonicWithWordlist(24) = %q, %v, want ErrInsecureSource", name, m, err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package random
+
+import (
+	"math"
+	"math/bits"
+	"sync"
+	"time"
+)
+
+// millisecond timestamp and random part of the last ID of a monotonic generator. IDs within the same millisecond
+// get the previous random part plus a random increment, so they keep increasing and stay hard to guess.
+type monotonic struct {
+	mtx sync.Mutex
+	// bits of the random part above the low 64
+	hiBits uint
+	ms     uint64
+	hi     uint64
+	lo     uint64
+}
+
+// returns timestamp and random part of the next ID, greater than the previous one even if the clock went back
+func (m *monotonic) next(r SFRand) (ms uint64, hi uint64, lo uint64) {
+	now := uint64(time.Now().UnixMilli())
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if now > m.ms {
+		m.ms = now
+		m.reseed(r)
+		return m.ms, m.hi, m.lo
+	}
+	lo, carry := bits.Add64(m.lo, r.Uint64(1, 1<<32), 0)
+	if hi := m.hi + carry; hi < 1<<m.hiBits {
+		m.hi, m.lo = hi, lo
+	} else {
+		// the random part overflowed, so the ID borrows the next millisecond
+		m.ms++
+		m.reseed(r)
+	}
+	return m.ms, m.hi, m.lo
+}
+
+func (m *monotonic) reseed(r SFRand) {
+	m.hi = r.Uint64(0, 1<<m.hiBits-1)
+	m.lo = r.Uint64(0, math.MaxUint64)
+}
@@ -0,0 +1,100 @@
+package random
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrNamerOrder   = errors.New("namer order must be at least 1")
+	ErrNamerSamples = errors.New("namer needs at least one non-empty sample name")
+)
+
+// attempts of Namer.Name to come up with a name that is not a sample before returning one anyway
+const namerAttempts = 100
+
+const (
+	// pads the context at the start of a name
+	namerStart = '\x00'
+	// marks the end of a name among the continuations
+	namerEnd rune = -1
+)
+
+// generates novel, plausible-sounding names, e.g. for characters, fantasy places or codenames, from a Markov
+// chain over the letters of sample names. Transitions are drawn from its SFRand. Namer is safe for concurrent
+// use if its SFRand is.
+type Namer struct {
+	r     SFRand
+	order int
+	// runes following each context of order runes, once per occurrence in the samples
+	next    map[string][]rune
+	samples map[string]struct{}
+	minLen  int
+	maxLen  int
+}
+
+// returns Namer trained on samples, where each rune depends on the order runes before it: 2 or 3 gives names
+// close to the style of the samples, higher orders copy longer parts of them. Samples are lowercased, and at
+// least a few dozen are needed for names that differ from them.
+func NewNamer(r SFRand, order int, samples ...string) (*Namer, error) {
+	if order < 1 {
+		return nil, ErrNamerOrder
+	}
+	n := &Namer{r: r, order: order, next: make(map[string][]rune), samples: make(map[string]struct{})}
+	for _, s := range samples {
+		runes := []rune(strings.ToLower(strings.TrimSpace(s)))
+		if len(runes) == 0 {
+			continue
+		}
+		n.samples[string(runes)] = struct{}{}
+		if n.minLen == 0 || len(runes) < n.minLen {
+			n.minLen = len(runes)
+		}
+		n.maxLen = max(n.maxLen, len(runes))
+		context := []rune(strings.Repeat(string(namerStart), order))
+		for _, c := range append(runes, namerEnd) {
+			key := string(context)
+			n.next[key] = append(n.next[key], c)
+			context = append(context[1:], c)
+		}
+	}
+	if len(n.samples) == 0 {
+		return nil, ErrNamerSamples
+	}
+	return n, nil
+}
+
+// returns name generated by the chain, as long as the samples' shortest to longest one and capitalized at the
+// start of each word. It differs from every sample unless the chain keeps reproducing them, which happens
+// with too few samples or too high an order.
+func (n *Namer) Name() string {
+	var name []rune
+	for i := 0; i < namerAttempts; i++ {
+		name = n.generate()
+		if _, ok := n.samples[string(name)]; !ok && len(name) >= n.minLen && len(name) <= n.maxLen {
+			break
+		}
+	}
+	for i := range name {
+		if i == 0 || name[i-1] == ' ' || name[i-1] == '-' {
+			name[i] = unicode.ToUpper(name[i])
+		}
+	}
+	return string(name)
+}
+
+// returns lowercase name walked through the chain, stopping at an end of a name or after maxLen+1 runes
+func (n *Namer) generate() []rune {
+	context := []rune(strings.Repeat(string(namerStart), n.order))
+	var name []rune
+	for len(name) <= n.maxLen {
+		c := Choice(n.r, n.next[string(context)])
+		if c == namerEnd {
+			break
+		}
+		name = append(name, c)
+		context = append(context[1:], c)
+	}
+	return name
+}
@@ -0,0 +1,31 @@
+package random
+
+// default NanoID alphabet, 64 URL-safe characters
+const nanoIDAlphabet = "useandom-26T198340PX75pxJACKVERYMINDBUSHWOLF_GQZbfghjklqvwyzrict"
+
+// returns n NanoIDs of length characters from the default URL-safe alphabet, drawing the entropy for all of them
+// in a single read. The alphabet has 64 characters, so every byte maps to a character without bias.
+func (r *randomizer) NanoIDs(n int, length int) []string {
+	b := r.Bytes(n * length)
+	out := make([]string, n)
+	for i := range out {
+		id := b[i*length : (i+1)*length]
+		for j, c := range id {
+			id[j] = nanoIDAlphabet[c&63]
+		}
+		out[i] = string(id)
+	}
+	return out
+}
+
+// length of a NanoID with about as many random bits as a version 4 UUID when using the default alphabet
+const NanoIDLength = 21
+
+// returns NanoID of length characters from alphabet, or from the default 64 character URL-safe alphabet if
+// alphabet is empty. Characters are drawn uniformly for alphabets of any size, e.g. NanoID(NanoIDLength, nil).
+func (r *randomizer) NanoID(length int, alphabet []rune) string {
+	if len(alphabet) == 0 {
+		return r.StringFromPool(length, nanoIDAlphabet)
+	}
+	return r.String(length, alphabet)
+}
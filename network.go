@@ -0,0 +1,127 @@
+package random
+
+import (
+	"net"
+	"slices"
+)
+
+// returns pseudo-random address inside the network given in CIDR notation, e.g. "10.0.0.0/8" or "2001:db8::/32",
+// each equally likely including the network and broadcast addresses. See HostInCIDR to exclude them.
+func (r *randomizer) IPInCIDR(cidr string) (net.IP, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	return r.ipIn(network, false), nil
+}
+
+// same as IPInCIDR but never returns the network address or, for IPv4, the broadcast address, unless the
+// network is too small to have other addresses: /31 and /32 for IPv4 (see RFC 3021), /128 for IPv6.
+func (r *randomizer) HostInCIDR(cidr string) (net.IP, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	return r.ipIn(network, true), nil
+}
+
+// returns pseudo-random address inside network, redrawing the network and broadcast addresses if hostsOnly
+func (r *randomizer) ipIn(network *net.IPNet, hostsOnly bool) net.IP {
+	ones, bits := network.Mask.Size()
+	v4 := bits == 8*net.IPv4len
+	if v4 && bits-ones < 2 || !v4 && bits == ones {
+		hostsOnly = false
+	}
+	for {
+		ip := net.IP(r.Bytes(len(network.IP)))
+		zeros, allOnes := true, true
+		for i := range ip {
+			host := ip[i] &^ network.Mask[i]
+			zeros = zeros && host == 0
+			allOnes = allOnes && host == ^network.Mask[i]
+			ip[i] = network.IP[i] | host
+		}
+		if !hostsOnly || !zeros && !(v4 && allOnes) {
+			return ip
+		}
+	}
+}
+
+var (
+	globalUnicast = mustParseCIDR("2000::/3")
+	uniqueLocal   = mustParseCIDR("fd00::/8")
+	linkLocal     = mustParseCIDR("fe80::/64")
+	// special-purpose ranges inside globalUnicast: IETF protocol assignments and documentation
+	ipv6Reserved = []*net.IPNet{
+		mustParseCIDR("2001::/23"),
+		mustParseCIDR("2001:db8::/32"),
+		mustParseCIDR("3fff::/20"),
+	}
+)
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// returns pseudo-random global unicast IPv6 address from 2000::/3, avoiding the special-purpose and
+// documentation ranges inside it
+func (r *randomizer) IPv6() net.IP {
+	for {
+		ip := r.ipIn(globalUnicast, false)
+		reserved := false
+		for _, network := range ipv6Reserved {
+			reserved = reserved || network.Contains(ip)
+		}
+		if !reserved {
+			return ip
+		}
+	}
+}
+
+// returns pseudo-random unique local IPv6 address from fd00::/8 with a random global ID as of RFC 4193,
+// random subnet ID and random interface ID
+func (r *randomizer) IPv6ULA() net.IP {
+	return r.ipIn(uniqueLocal, false)
+}
+
+// returns pseudo-random link-local IPv6 address from fe80::/64 with a random interface ID
+func (r *randomizer) IPv6LinkLocal() net.IP {
+	return r.ipIn(linkLocal, true)
+}
+
+// returns pseudo-random port between min and max, inclusive, that is not in exclude, each allowed port being
+// equally likely. It panics if the range is not within [0,65535], max < min or every port in it is excluded.
+func (r *randomizer) Port(min int, max int, exclude ...int) int {
+	if min < 0 || max > 65535 || max < min {
+		panic("invalid argument to Port: need 0 <= min <= max <= 65535")
+	}
+	excluded := make([]int, 0, len(exclude))
+	for _, p := range exclude {
+		if p >= min && p <= max {
+			excluded = append(excluded, p)
+		}
+	}
+	slices.Sort(excluded)
+	excluded = slices.Compact(excluded)
+	if len(excluded) == max-min+1 {
+		panic("invalid argument to Port: every port is excluded")
+	}
+	// the k-th allowed port is min+k shifted past every excluded port below it
+	p := min + r.Int(0, max-min-len(excluded))
+	for _, e := range excluded {
+		if e > p {
+			break
+		}
+		p++
+	}
+	return p
+}
+
+// returns pseudo-random port from the dynamic range 49152-65535 that IANA reserves for ephemeral ports
+func (r *randomizer) EphemeralPort() int {
+	return r.Port(49152, 65535)
+}
@@ -0,0 +1,150 @@
+package random
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"testing"
+)
+
+// returns the distinct addresses f returned for cidr in 2000 calls, failing t if one is outside cidr
+func drawAddresses(t *testing.T, cidr string, f func(string) (net.IP, error)) []string {
+	t.Helper()
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for i := 0; i < 2000; i++ {
+		ip, err := f(cidr)
+		if err != nil {
+			t.Fatalf("%s: %v", cidr, err)
+		}
+		if !network.Contains(ip) {
+			t.Fatalf("%s: %v is outside the network", cidr, ip)
+		}
+		seen[ip.String()] = true
+	}
+	out := make([]string, 0, len(seen))
+	for ip := range seen {
+		out = append(out, ip)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestIPInCIDR(t *testing.T) {
+	r := NewSFRand()
+	for _, tc := range []struct {
+		cidr       string
+		all, hosts []string
+	}{
+		{"192.168.1.7/32", []string{"192.168.1.7"}, []string{"192.168.1.7"}},
+		{"10.0.0.0/31", []string{"10.0.0.0", "10.0.0.1"}, []string{"10.0.0.0", "10.0.0.1"}},
+		{"10.0.0.0/30", []string{"10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"}, []string{"10.0.0.1", "10.0.0.2"}},
+		{"10.1.2.255/30", []string{"10.1.2.252", "10.1.2.253", "10.1.2.254", "10.1.2.255"}, []string{"10.1.2.253", "10.1.2.254"}},
+		{"2001:db8::1/128", []string{"2001:db8::1"}, []string{"2001:db8::1"}},
+		{"2001:db8::/127", []string{"2001:db8::", "2001:db8::1"}, []string{"2001:db8::1"}},
+		{"2001:db8::/126", []string{"2001:db8::", "2001:db8::1", "2001:db8::2", "2001:db8::3"}, []string{"2001:db8::1", "2001:db8::2", "2001:db8::3"}},
+	} {
+		if got := drawAddresses(t, tc.cidr, r.IPInCIDR); !slices.Equal(got, tc.all) {
+			t.Errorf("IPInCIDR(%s) returned %v, want %v", tc.cidr, got, tc.all)
+		}
+		if got := drawAddresses(t, tc.cidr, r.HostInCIDR); !slices.Equal(got, tc.hosts) {
+			t.Errorf("HostInCIDR(%s) returned %v, want %v", tc.cidr, got, tc.hosts)
+		}
+	}
+}
+
+func TestIPInCIDRWide(t *testing.T) {
+	r := NewSFRand()
+	for _, tc := range []struct {
+		cidr string
+		v4   bool
+	}{
+		{"0.0.0.0/0", true},
+		{"10.0.0.0/8", true},
+		{"::/0", false},
+		{"2001:db8::/32", false},
+	} {
+		for _, f := range []func(string) (net.IP, error){r.IPInCIDR, r.HostInCIDR} {
+			if got := drawAddresses(t, tc.cidr, f); len(got) < 1990 {
+				t.Errorf("%s: only %d distinct addresses in 2000 draws", tc.cidr, len(got))
+			}
+		}
+		if ip, _ := r.IPInCIDR(tc.cidr); (len(ip) == net.IPv4len) != tc.v4 {
+			t.Errorf("IPInCIDR(%s) = %v has %d bytes", tc.cidr, ip, len(ip))
+		}
+	}
+}
+
+func TestIPInCIDRInvalid(t *testing.T) {
+	r := NewSFRand()
+	for _, cidr := range []string{"", "10.0.0.0", "10.0.0.0/33", "::/129", "example.com/8"} {
+		if ip, err := r.IPInCIDR(cidr); err == nil {
+			t.Errorf("IPInCIDR(%q) = %v, want an error", cidr, ip)
+		}
+		if ip, err := r.HostInCIDR(cidr); err == nil {
+			t.Errorf("HostInCIDR(%q) = %v, want an error", cidr, ip)
+		}
+	}
+}
+
+func TestPort(t *testing.T) {
+	r := NewSFRand()
+	for _, tc := range []struct {
+		min, max int
+		exclude  []int
+		want     []int
+	}{
+		{80, 80, nil, []int{80}},
+		{0, 3, []int{1}, []int{0, 2, 3}},
+		{0, 3, []int{0, 3}, []int{1, 2}},
+		{0, 3, []int{2, 1, 2, 99, -1}, []int{0, 3}},
+		{65530, 65535, []int{65535, 65530}, []int{65531, 65532, 65533, 65534}},
+		{1000, 1009, []int{1009, 1000, 1005}, []int{1001, 1002, 1003, 1004, 1006, 1007, 1008}},
+	} {
+		const trials = 7000
+		counts := map[int]int{}
+		for i := 0; i < trials; i++ {
+			counts[r.Port(tc.min, tc.max, tc.exclude...)]++
+		}
+		for _, p := range tc.want {
+			checkBinomial(t, fmt.Sprintf("Port(%d, %d, %v) = %d", tc.min, tc.max, tc.exclude, p), counts[p], trials, 1/float64(len(tc.want)))
+			delete(counts, p)
+		}
+		if len(counts) != 0 {
+			t.Errorf("Port(%d, %d, %v) returned unexpected ports %v", tc.min, tc.max, tc.exclude, counts)
+		}
+	}
+}
+
+func TestPortPanics(t *testing.T) {
+	for _, tc := range []struct {
+		min, max int
+		exclude  []int
+	}{
+		{-1, 10, nil},
+		{0, 65536, nil},
+		{10, 9, nil},
+		{5, 6, []int{6, 5}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Port(%d, %d, %v) did not panic", tc.min, tc.max, tc.exclude)
+				}
+			}()
+			NewSFRand().Port(tc.min, tc.max, tc.exclude...)
+		}()
+	}
+}
+
+func TestEphemeralPort(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		if p := NewSFRand().EphemeralPort(); p < 49152 || p > 65535 {
+			t.Fatalf("EphemeralPort() = %d", p)
+		}
+	}
+}
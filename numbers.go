@@ -0,0 +1,158 @@
+package random
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// returned by IntChecked when max < min
+var ErrInvalidRange = errors.New("invalid range: max < min")
+
+// same as Int but returns an error wrapping ErrInvalidRange instead of panicking when max < min. Every other
+// range is valid, including ones whose span overflows int such as [math.MinInt, math.MaxInt].
+func (r *randomizer) IntChecked(min int, max int) (int, error) {
+	if max < min {
+		return 0, fmt.Errorf("%w: Int(%d, %d)", ErrInvalidRange, min, max)
+	}
+	return r.Int(min, max), nil
+}
+
+// returns pseudo-random 32-bit value as a uint32
+func (r *randomizer) Uint32() uint32 {
+	return binary.LittleEndian.Uint32(r.Bytes(4))
+}
+
+// returns non-negative pseudo-random 31-bit integer as an int32
+func (r *randomizer) Int31() int32 {
+	return int32(r.Uint32() >> 1)
+}
+
+// returns non-negative pseudo-random 63-bit integer as an int64
+func (r *randomizer) Int63() int64 {
+	return int64(r.uint64() >> 1)
+}
+
+// returns pseudo-random uint64 over the whole uint64 range, see Uint64 for a narrower one
+func (r *randomizer) Uint64Full() uint64 {
+	return r.uint64()
+}
+
+// returns pseudo-random int over the whole int range, negative values included, see Int for a narrower one
+func (r *randomizer) IntFull() int {
+	return int(r.uint64())
+}
+
+// returns non-negative pseudo-random int64 in [0,n). It panics if n <= 0.
+func (r *randomizer) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	return int64(r.uint64n(uint64(n)))
+}
+
+// returns pseudo-random float32 in [0.0,1.0)
+func (r *randomizer) Float32() float32 {
+	// 24 bits fill the float32 mantissa exactly, so every value is equally likely
+	return float32(r.Uint32()>>8) / (1 << 24)
+}
+
+// returns pseudo-random int64 between min and max, inclusive, for any range including the full int64 one.
+// It panics if max < min.
+func (r *randomizer) Int64(min int64, max int64) int64 {
+	if max < min {
+		panic("invalid argument to Int64: max < min")
+	}
+	// the span is computed in uint64 where it cannot overflow, and wraps back into place when added
+	return min + int64(r.uint64UpTo(uint64(max)-uint64(min)))
+}
+
+// returns pseudo-random uint64 between min and max, inclusive, for any range including the full uint64 one.
+// It panics if max < min.
+func (r *randomizer) Uint64(min uint64, max uint64) uint64 {
+	if max < min {
+		panic("invalid argument to Uint64: max < min")
+	}
+	return min + r.uint64UpTo(max-min)
+}
+
+// returns pseudo-random float64 in [0.0,1.0)
+func (r *randomizer) Float64() float64 {
+	// 53 bits fill the float64 mantissa exactly, so every value is equally likely
+	return float64(r.uint64()>>11) / (1 << 53)
+}
+
+// returns pseudo-random float64 in [min,max), or min if both are equal. It panics if max < min or either
+// is not finite.
+func (r *randomizer) Float64Range(min float64, max float64) float64 {
+	if !(min <= max) || math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("invalid argument to Float64Range")
+	}
+	if min == max {
+		return min
+	}
+	// interpolating instead of min + f*(max-min) keeps spans wider than math.MaxFloat64 from overflowing. It can
+	// round to max, or in theory past either bound, so the result is clamped rather than drawn again, which would
+	// never end for a source stuck near 1.
+	f := r.Float64()
+	return clamp(min*(1-f)+max*f, min, math.Nextafter(max, min))
+}
+
+// returns pseudo-random float32 in [min,max), or min if both are equal. It panics if max < min or either
+// is not finite.
+func (r *randomizer) Float32Range(min float32, max float32) float32 {
+	if !(min <= max) || math.IsInf(float64(min), 0) || math.IsInf(float64(max), 0) {
+		panic("invalid argument to Float32Range")
+	}
+	if min == max {
+		return min
+	}
+	// rounding to float32 can reach max
+	return clamp(float32(r.Float64Range(float64(min), float64(max))), min, math.Nextafter32(max, min))
+}
+
+// returns v limited to [lo, hi]
+func clamp[T float32 | float64](v T, lo T, hi T) T {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+func (r *randomizer) uint64() uint64 {
+	if s, ok := r.src.(uint64Source); ok {
+		return s.Uint64()
+	}
+	if u, ok := r.src.(uint64Reader); ok {
+		if v, err := u.uint64(); err == nil {
+			return v
+		}
+	}
+	return binary.LittleEndian.Uint64(r.Bytes(8))
+}
+
+// returns uniformly distributed uint64 in [0,n), n must be greater than 0
+func (r *randomizer) uint64n(n uint64) uint64 {
+	if n&(n-1) == 0 {
+		return r.uint64() & (n - 1)
+	}
+	// values below 2^64 mod n are rejected so that every remainder is equally likely
+	limit := -n % n
+	for {
+		if v := r.uint64(); v >= limit {
+			return v % n
+		}
+	}
+}
+
+// returns uniformly distributed uint64 in [0,max]
+func (r *randomizer) uint64UpTo(max uint64) uint64 {
+	if max == ^uint64(0) {
+		return r.uint64()
+	}
+	return r.uint64n(max + 1)
+}
@@ -0,0 +1,56 @@
+package random
+
+import (
+	"math"
+	"testing"
+)
+
+// entropy source returning the same byte forever
+type constReader byte
+
+func (c constReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(c)
+	}
+	return len(p), nil
+}
+
+func TestFloat64Range(t *testing.T) {
+	tests := []struct {
+		min, max float64
+	}{
+		{0, 1},
+		{-1, 1},
+		{0.1, 0.3},
+		{-math.MaxFloat64, math.MaxFloat64},
+		{math.MaxFloat64 / 2, math.MaxFloat64},
+		{1, math.Nextafter(1, 2)},
+		{-math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64},
+		{1e300, 1e300 + 1e285},
+	}
+	sources := map[string]SFRand{
+		"crypto": NewSFRand(),
+		// Float64 returns its largest value, which interpolates to max
+		"ones":  NewSFRand(WithEntropySource(constReader(0xff))),
+		"zeros": NewSFRand(WithEntropySource(constReader(0))),
+	}
+	for name, r := range sources {
+		for _, tt := range tests {
+			for i := 0; i < 1000; i++ {
+				if v := r.Float64Range(tt.min, tt.max); !(v >= tt.min && v < tt.max) {
+					t.Fatalf("%s: Float64Range(%g, %g) = %g", name, tt.min, tt.max, v)
+				}
+				lo, hi := float32(tt.min), float32(tt.max)
+				if lo == hi || math.IsInf(float64(lo), 0) || math.IsInf(float64(hi), 0) {
+					continue
+				}
+				if v := r.Float32Range(lo, hi); !(v >= lo && v < hi) {
+					t.Fatalf("%s: Float32Range(%g, %g) = %g", name, lo, hi, v)
+				}
+			}
+		}
+	}
+	if v := NewSFRand().Float64Range(2, 2); v != 2 {
+		t.Errorf("Float64Range(2, 2) = %g", v)
+	}
+}
@@ -0,0 +1,14 @@
+package random
+
+// returns nil with probability nilProb, otherwise a pointer to a value from gen, so fixtures exercise
+// both present and absent optional fields. It panics if nilProb is not in [0,1].
+func Ptr[T any](r SFRand, gen func() T, nilProb float64) *T {
+	if !(nilProb >= 0 && nilProb <= 1) {
+		panic("invalid argument to Ptr: nilProb must be in [0,1]")
+	}
+	if r.Probability(nilProb) {
+		return nil
+	}
+	v := gen()
+	return &v
+}
@@ -0,0 +1,104 @@
+package random
+
+import (
+	cryptorand "crypto/rand"
+	"io"
+)
+
+// configures NewSFRand
+type Option func(*options)
+
+type options struct {
+	fallbackDisabled bool
+	seed             *int64
+	src              io.Reader
+	logger           Logger
+	sharded          bool
+	chacha           bool
+	onFallback       func(op string, err error)
+}
+
+// makes the SFRand never fall back to math/rand: E variants of methods return an error wrapping
+// ErrEntropyUnavailable when the entropy source fails and all other methods panic with it.
+// Unlike NewSFRandStrict, construction does not check that the entropy source works.
+func WithFallbackDisabled() Option {
+	return func(o *options) {
+		o.fallbackDisabled = true
+	}
+}
+
+// makes the SFRand the deterministic generator of NewSeededSFRand: its output is fully determined by seed and
+// must never be used for secrets. WithEntropySource, WithSharding and WithChaCha20 have no effect together with it.
+func WithSeed(seed int64) Option {
+	return func(o *options) {
+		o.seed = &seed
+	}
+}
+
+// makes the SFRand read its randomness from src instead of crypto/rand, e.g. an HSM-backed reader, /dev/hwrng
+// or a deterministic reader in tests. Failures of src are handled like failures of crypto/rand.
+func WithEntropySource(src io.Reader) Option {
+	return func(o *options) {
+		o.src = src
+	}
+}
+
+// makes the SFRand read crypto/rand through one buffer per P instead of a single buffer behind a mutex, similar
+// to how the runtime shards math/rand/v2, so that heavy parallel use does not contend on that mutex. It costs
+// a 4KB buffer per P and has no effect together with WithEntropySource.
+func WithSharding() Option {
+	return func(o *options) {
+		o.sharded = true
+	}
+}
+
+// makes the SFRand draw from a ChaCha20 DRBG in userspace instead of crypto/rand directly. Where reading
+// crypto/rand is a syscall, as before go1.24 on linux, this saves a syscall per 4KB of output; where it
+// already is a userspace generator, throughput is about the same. The DRBG is seeded from
+// crypto/rand on first use, mixes in fresh bytes of it every MiB of output and replaces its key after every
+// batch of output it hands out, so earlier output stays secret even if its state leaks. Combined with
+// WithSharding, every P gets its own DRBG. Together with WithEntropySource, the DRBG is seeded from that
+// source instead of crypto/rand and failures to seed it are handled like failures of the source.
+func WithChaCha20() Option {
+	return func(o *options) {
+		o.chacha = true
+	}
+}
+
+// makes the SFRand call f whenever it falls back to its insecure generator because the entropy source failed,
+// with the name of the method, e.g. "Int" or "Bytes", and the error of the source. f is called synchronously
+// before the fallback value is generated and must be safe for concurrent use. See also FallbackCount.
+func OnFallback(f func(op string, err error)) Option {
+	return func(o *options) {
+		o.onFallback = f
+	}
+}
+
+// makes the SFRand write warnings, e.g. about falling back to math/rand, to l instead of the standard logger.
+// Use SlogLogger for a *slog.Logger, or log.New(io.Discard, "", 0) to drop them.
+func WithLogger(l Logger) Option {
+	return func(o *options) {
+		o.logger = l
+	}
+}
+
+// returns the entropy source of the SFRand. crypto/rand is read in blocks, short reads dominate otherwise when
+// generating IDs at high rates.
+func (o *options) source() io.Reader {
+	if o.src != nil {
+		if o.chacha {
+			return newBufferedSource(newChaChaDRBG(o.src), entropyBufferSize)
+		}
+		return o.src
+	}
+	newSrc := func() io.Reader {
+		if o.chacha {
+			return newChaChaDRBG(cryptorand.Reader)
+		}
+		return cryptorand.Reader
+	}
+	if o.sharded {
+		return newShardedSource(newSrc, entropyBufferSize)
+	}
+	return newBufferedSource(newSrc(), entropyBufferSize)
+}
@@ -0,0 +1,37 @@
+package random
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestPassphraseDuplicateWords(t *testing.T) {
+	// repeated words must neither be drawn more often nor count towards the entropy
+	r := NewSFRand()
+	list := []string{"a", "b", "a", "a", "c", "b"}
+	seen := map[string]int{}
+	for i := 0; i < 3000; i++ {
+		p, _ := r.Passphrase(1, "", WithWordlist(list))
+		seen[p]++
+	}
+	for w, n := range seen {
+		if n < 800 || n > 1200 {
+			t.Errorf("%q drawn %d times out of 3000 from %q, want about 1000", w, n, list)
+		}
+	}
+	if _, bits := r.Passphrase(2, "", WithWordlist(list)); math.Abs(bits-2*math.Log2(3)) > 1e-9 {
+		t.Errorf("Passphrase(2) from %q has %v bits of entropy, want %v", list, bits, 2*math.Log2(3))
+	}
+}
+
+func TestPassphraseInsecureSource(t *testing.T) {
+	if p, bits := NewSFRand().Passphrase(6, " "); bits < 77 || bits > 78 || len(strings.Fields(p)) != 6 {
+		t.Errorf("Passphrase(6) = %q, %v bits, want 6 words of about 77.5 bits", p, bits)
+	}
+	for name, r := range insecureRandomizers() {
+		if p, bits := r.Passphrase(6, " "); bits != 0 {
+			t.Errorf("%s: Passphrase(6) = %q, %v bits, want 0 bits", name, p, bits)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package random
+
+import "strings"
+
+// NATO phonetic alphabet words for the runes of GetAlphaNumericLowercasePool
+var phoneticWords = map[rune]string{
+	'a': "alpha",
+	'b': "bravo",
+	'c': "charlie",
+	'd': "delta",
+	'e': "echo",
+	'f': "foxtrot",
+	'g': "golf",
+	'h': "hotel",
+	'i': "india",
+	'j': "juliet",
+	'k': "kilo",
+	'l': "lima",
+	'm': "mike",
+	'n': "november",
+	'o': "oscar",
+	'p': "papa",
+	'q': "quebec",
+	'r': "romeo",
+	's': "sierra",
+	't': "tango",
+	'u': "uniform",
+	'v': "victor",
+	'w': "whiskey",
+	'x': "x-ray",
+	'y': "yankee",
+	'z': "zulu",
+	'0': "zero",
+	'1': "one",
+	'2': "two",
+	'3': "three",
+	'4': "four",
+	'5': "five",
+	'6': "six",
+	'7': "seven",
+	'8': "eight",
+	'9': "nine",
+}
+
+// returns code of pseudo-random letters and digits spelled out in the NATO phonetic alphabet and
+// joined with dashes, e.g. "alpha-tango-seven-echo" for 4 words, for codes read out loud
+func (r *randomizer) PhoneticCode(words int) string {
+	pool := GetAlphaNumericLowercasePool()
+	out := make([]string, words)
+	for i := range out {
+		out[i] = phoneticWords[r.Rune(pool)]
+	}
+	return strings.Join(out, "-")
+}
@@ -0,0 +1,146 @@
+package random
+
+import (
+	"errors"
+	"io"
+	"math/big"
+)
+
+// returned by Prime and SafePrime for bit lengths too small to hold such a prime
+var ErrPrimeBits = errors.New("prime must have at least 2 bits, safe prime at least 3")
+
+// returns number of the given bit length that is prime with high probability. Like crypto/rand.Prime it sets
+// the top two bits, so that the product of two such primes has exactly 2*bits bits, but it draws the candidates
+// from the entropy source itself, as crypto/rand.Prime reads from crypto/rand instead of the given reader since
+// go1.26. Instead of falling back to math/rand an error wrapping ErrEntropyUnavailable is returned, and
+// randomizers backed by a seeded generator, see NewSeededSFRand and NewFastInsecure, return ErrInsecureSource.
+func (r *randomizer) Prime(bits int) (*big.Int, error) {
+	if bits < 2 {
+		return nil, ErrPrimeBits
+	}
+	if r.insecure {
+		return nil, ErrInsecureSource
+	}
+	top := (bits-1)%8 + 1
+	b := make([]byte, (bits+7)/8)
+	p, mod := new(big.Int), new(big.Int)
+	rems := make([]uint64, len(sievePrimes))
+	for {
+		if _, err := io.ReadFull(r.src, b); err != nil {
+			return nil, entropyError(err)
+		}
+		b[0] &= byte(1<<top - 1)
+		if top >= 2 {
+			b[0] |= 3 << (top - 2)
+		} else {
+			b[0] |= 1
+			b[1] |= 0x80
+		}
+		b[len(b)-1] |= 1
+		p.SetBytes(b)
+		if bits <= sieveBits {
+			if p.ProbablyPrime(20) {
+				return p, nil
+			}
+			continue
+		}
+		// candidates p+delta are sieved with the remainders of p by small primes before the expensive
+		// primality test, like in SafePrime
+		for i, s := range sievePrimes {
+			rems[i] = mod.Mod(p, mod.SetUint64(s)).Uint64()
+		}
+	next:
+		for delta := uint64(0); delta < 1<<20; delta += 2 {
+			for i, s := range sievePrimes {
+				if (rems[i]+delta)%s == 0 {
+					continue next
+				}
+			}
+			p.Add(p, mod.SetUint64(delta))
+			if p.BitLen() > bits {
+				break
+			}
+			if p.ProbablyPrime(20) {
+				return p, nil
+			}
+			p.Sub(p, mod.SetUint64(delta))
+		}
+	}
+}
+
+// returns safe prime of the given bit length, a prime p for which (p-1)/2 is prime too, as needed e.g. for
+// Diffie-Hellman groups. It behaves like Prime but takes far longer: around a second for 1024 bits and
+// around a minute for 2048 bits.
+func (r *randomizer) SafePrime(bits int) (*big.Int, error) {
+	if bits < 3 {
+		return nil, ErrPrimeBits
+	}
+	if r.insecure {
+		return nil, ErrInsecureSource
+	}
+	// q is drawn with exactly bits-1 bits, so that p = 2q+1 has exactly bits
+	qBits := bits - 1
+	top := (qBits-1)%8 + 1
+	b := make([]byte, (qBits+7)/8)
+	q, p, mod := new(big.Int), new(big.Int), new(big.Int)
+	rems := make([]uint64, len(sievePrimes))
+	for {
+		if _, err := io.ReadFull(r.src, b); err != nil {
+			return nil, entropyError(err)
+		}
+		b[0] &= byte(1<<top - 1)
+		b[0] |= 1 << (top - 1)
+		q.SetBytes(b)
+		if qBits <= sieveBits {
+			p.Lsh(q, 1).Add(p, one)
+			if p.ProbablyPrime(20) && q.ProbablyPrime(20) {
+				return p, nil
+			}
+			continue
+		}
+		// candidates q+delta are sieved with the remainders of q by small primes s, rejecting those where s
+		// divides q+delta or 2(q+delta)+1, before the expensive primality tests
+		q.SetBit(q, 0, 1)
+		for i, s := range sievePrimes {
+			rems[i] = mod.Mod(q, mod.SetUint64(s)).Uint64()
+		}
+	next:
+		for delta := uint64(0); delta < 1<<20; delta += 2 {
+			for i, s := range sievePrimes {
+				if m := (rems[i] + delta) % s; m == 0 || m == s/2 {
+					continue next
+				}
+			}
+			q.Add(q, mod.SetUint64(delta))
+			if q.BitLen() > qBits {
+				break
+			}
+			p.Lsh(q, 1).Add(p, one)
+			if p.ProbablyPrime(20) && q.ProbablyPrime(20) {
+				return p, nil
+			}
+			q.Sub(q, mod.SetUint64(delta))
+		}
+	}
+}
+
+var one = big.NewInt(1)
+
+// candidates for Prime and SafePrime with more than sieveBits bits exceed every prime of sievePrimes
+const sieveBits = 12
+
+// odd primes below 2^sieveBits
+var sievePrimes = func() []uint64 {
+	var primes []uint64
+	composite := make([]bool, 1<<sieveBits)
+	for i := uint64(3); i < 1<<sieveBits; i += 2 {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j < 1<<sieveBits; j += 2 * i {
+			composite[j] = true
+		}
+	}
+	return primes
+}()
@@ -0,0 +1,71 @@
+package random
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestPrime(t *testing.T) {
+	r := NewSFRand()
+	for _, bits := range []int{2, 3, 8, 9, 12, 13, 64, 256} {
+		p, err := r.Prime(bits)
+		if err != nil {
+			t.Fatalf("Prime(%d): %v", bits, err)
+		}
+		if p.BitLen() != bits || p.Bit(bits-2) != 1 || !p.ProbablyPrime(20) {
+			t.Errorf("Prime(%d) = %v, want prime of %d bits with the top two set", bits, p, bits)
+		}
+	}
+	if _, err := r.Prime(1); !errors.Is(err, ErrPrimeBits) {
+		t.Errorf("Prime(1) error = %v, want ErrPrimeBits", err)
+	}
+}
+
+func TestPrimeEntropySource(t *testing.T) {
+	// candidates come from the configured source, so equal sources yield equal primes
+	a := NewSFRand(WithEntropySource(newXoshiro(42)))
+	b := NewSFRand(WithEntropySource(newXoshiro(42)))
+	for _, bits := range []int{12, 128, 512} {
+		p, err := a.Prime(bits)
+		if err != nil {
+			t.Fatalf("Prime(%d): %v", bits, err)
+		}
+		if q, _ := b.Prime(bits); p.Cmp(q) != 0 {
+			t.Errorf("Prime(%d) from equal sources = %v and %v", bits, p, q)
+		}
+	}
+	r := NewSFRand(WithEntropySource(bytes.NewReader(nil)), WithFallbackDisabled())
+	if p, err := r.Prime(64); !errors.Is(err, ErrEntropyUnavailable) {
+		t.Errorf("Prime(64) from an empty source = %v, %v, want ErrEntropyUnavailable", p, err)
+	}
+}
+
+func TestPrimeInsecureSource(t *testing.T) {
+	for name, r := range map[string]SFRand{
+		"seeded": NewSeededSFRand(1),
+		"fast":   NewFastInsecure(),
+	} {
+		if p, err := r.Prime(64); !errors.Is(err, ErrInsecureSource) {
+			t.Errorf("%s: Prime(64) = %v, %v, want ErrInsecureSource", name, p, err)
+		}
+		if p, err := r.SafePrime(64); !errors.Is(err, ErrInsecureSource) {
+			t.Errorf("%s: SafePrime(64) = %v, %v, want ErrInsecureSource", name, p, err)
+		}
+	}
+}
+
+func TestSafePrime(t *testing.T) {
+	r := NewSFRand()
+	for _, bits := range []int{3, 4, 5, 16, 64} {
+		p, err := r.SafePrime(bits)
+		if err != nil {
+			t.Fatalf("SafePrime(%d): %v", bits, err)
+		}
+		q := new(big.Int).Rsh(p, 1)
+		if p.BitLen() != bits || !p.ProbablyPrime(20) || !q.ProbablyPrime(20) {
+			t.Errorf("SafePrime(%d) = %v, want safe prime of %d bits", bits, p, bits)
+		}
+	}
+}
@@ -1,49 +1,195 @@
 package random
 
 import (
+	"context"
 	cryptorand "crypto/rand"
 	"encoding/binary"
-	"log"
+	"fmt"
+	"io"
 	"math/big"
 	mathrand "math/rand"
+	"net"
 	"sync"
+	"sync/atomic"
 	"time"
+	"unicode"
 )
 
 type SFRand interface {
 	Int(min int, max int) int
+	IntChecked(min int, max int) (int, error)
 	Bytes(n int) []byte
+	Reader() io.Reader
+	AsSource() mathrand.Source64
+	FallbackCount() uint64
+	Read(p []byte) (int, error)
+	Fill(p []byte) error
 	Bool() bool
+	Ints(count int, min int, max int) []int
+	Strings(count int, length int, pool []rune) []string
+	BoolSlice(count int) []bool
+	Uint32() uint32
+	Int31() int32
+	Int63() int64
+	Int63n(n int64) int64
+	Float32() float32
+	Float64() float64
+	Float64Range(min float64, max float64) float64
+	Float32Range(min float32, max float32) float32
+	Int64(min int64, max int64) int64
+	Uint64(min uint64, max uint64) uint64
+	Uint64Full() uint64
+	IntFull() int
+	Prime(bits int) (*big.Int, error)
+	SafePrime(bits int) (*big.Int, error)
+	NormFloat64(mean float64, stddev float64) float64
+	ExpFloat64(rate float64) float64
+	Poisson(lambda float64) int
+	Probability(p float64) bool
+	OneIn(n int) bool
+	PickIndex(n int) int
+	ShuffleFunc(n int, swap func(i, j int))
+	Perm(n int) []int
 	Rune(pool []rune) rune
-	String(length int, pool []rune) string
+	String(length int, pool []rune, opts ...StringOption) string
+	IntE(min int, max int) (int, error)
+	BytesE(n int) ([]byte, error)
+	RuneE(pool []rune) (rune, error)
+	StringE(length int, pool []rune, opts ...StringOption) (string, error)
+	StringBetween(minLen int, maxLen int, pool []rune) string
+	StringMatching(length int, pool []rune, ok func(string) bool, maxAttempts int) (string, error)
+	RuneFromString(pool string) rune
+	StringFromPool(length int, pool string) string
+	FromMask(mask string) string
+	Code(format string, pool []rune) string
+	PIN(digits int) string
+	APIKey(prefix string, entropyBytes int) string
+	RecoveryCodes(n int, length int) ([]string, error)
+	TOTPSecret(bits int) (string, error)
+	Nonce(n int) []byte
+	NonceGCM() []byte
+	NonceXChaCha() []byte
+	Salt() []byte
+	SaltN(n int) []byte
+	RandomCase(s string) string
+	Homoglyphs(s string, n int) []string
+	ShuffleLines(src io.Reader, w io.Writer, tmpDir string) error
+	PhoneticCode(words int) string
+	AvatarSeed() [32]byte
+	Location() *time.Location
+	Duration(min time.Duration, max time.Duration) time.Duration
+	Time(start time.Time, end time.Time) time.Time
+	Weekday() time.Weekday
+	Month() time.Month
+	DayOfMonth(year int, month time.Month) int
+	IPInCIDR(cidr string) (net.IP, error)
+	HostInCIDR(cidr string) (net.IP, error)
+	IPv6() net.IP
+	IPv6ULA() net.IP
+	IPv6LinkLocal() net.IP
+	Port(min int, max int, exclude ...int) int
+	EphemeralPort() int
+	LatLng() (lat float64, lng float64)
+	LatLngInBounds(minLat float64, maxLat float64, minLng float64, maxLng float64) (lat float64, lng float64)
+	PointInCircle(cx float64, cy float64, radius float64) (x float64, y float64)
+	PointOnSphere() (x float64, y float64, z float64)
+	SleepJitter(ctx context.Context, min time.Duration, max time.Duration) error
+	BackoffSchedule(attempts int, base time.Duration, cap time.Duration, strategy JitterStrategy) []time.Duration
+	Jitter(d time.Duration, fraction float64) time.Duration
+	FullJitter(base time.Duration, cap time.Duration, attempt int) time.Duration
+	EqualJitter(base time.Duration, cap time.Duration, attempt int) time.Duration
+	UserAgent(opts ...UserAgentOption) string
+	UUID() string
+	UUIDs(n int) []string
+	UUIDv7() string
+	ULID() string
+	ULIDs(n int) []string
+	NanoID(length int, alphabet []rune) string
+	NanoIDs(n int, length int) []string
+	Mnemonic(words int) (string, error)
+	MnemonicWithWordlist(words int, wordlist []string, separator string) (string, error)
+	Passphrase(words int, separator string, opts ...PassphraseOption) (string, float64)
+	Word() string
+	Words(n int) []string
+	Base58(nBytes int) string
+	Base58Check(version byte, payloadLen int) string
+	Hex(nBytes int) string
+	Base64(nBytes int) string
+	Base64URL(nBytes int) string
+	RawBase64(nBytes int) string
+	RawBase64URL(nBytes int) string
+	Base32(nBytes int) string
+	RawBase32(nBytes int) string
+	CrockfordID(length int, withCheck bool) string
 }
 
 type randomizer struct {
-	rnd *mathrand.Rand
+	// fallback generator, see newFallback
+	rnd io.Reader
 	mtx sync.Mutex
+	// source of random bytes, buffered crypto/rand unless configured otherwise
+	src io.Reader
+	// panic with an entropyError instead of falling back to rnd when src fails
+	strict bool
+	// src is a seeded pseudo-random generator, so secrets such as mnemonics are refused
+	insecure bool
+	// src is the runtime's generator, which Int and fill use directly, see NewFastInsecure
+	fast bool
+	// receives warnings, the standard logger if nil
+	logger Logger
+	// called on every fallback to rnd, if not nil
+	onFallback func(op string, err error)
+	// number of fallbacks to rnd
+	fallbacks atomic.Uint64
 }
 
-func NewSFRand() SFRand {
-	b := make([]byte, 8)
-	_, err := cryptorand.Read(b)
+// returns SFRand drawing from crypto/rand that falls back to math/rand/v2's ChaCha8, or math/rand before
+// go1.23, logging the failure, if crypto/rand fails. The fallback is seeded from crypto/rand, or from the
+// current time if that fails too. opts change this behavior, see Option.
+func NewSFRand(opts ...Option) SFRand {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	r := &randomizer{strict: o.fallbackDisabled, logger: o.logger, onFallback: o.onFallback}
+	if o.seed != nil {
+		r.rnd = newFallback(expandSeed(uint64(*o.seed)))
+		r.src = newXoshiro(uint64(*o.seed))
+		r.insecure = true
+		return r
+	}
+	r.src = o.source()
+	if o.fallbackDisabled {
+		return r
+	}
+
+	var seed [32]byte
+	_, err := cryptorand.Read(seed[:])
 	if err != nil {
-		log.Printf(
-			"failed to seed fallback math/rand package with cryptographically secure random number generator. Reason: %s\n",
+		r.logf(
+			"failed to seed fallback generator with cryptographically secure random number generator. Reason: %s\n",
 			err.Error(),
 		)
-		return &randomizer{
-			rnd: mathrand.New(mathrand.NewSource(time.Now().UnixNano())),
-		} // fallback to insecure seed by time
+		r.rnd = newFallback(expandSeed(uint64(time.Now().UnixNano()))) // fallback to insecure seed by time
+		return r
 	}
 
-	return &randomizer{rnd: mathrand.New(mathrand.NewSource(int64(binary.LittleEndian.Uint64(b))))}
+	r.rnd = newFallback(seed)
+	return r
 }
 
-// returns pseudo-random int between min and max, inclusive. It panics if max <= 0.
+// returns pseudo-random int between min and max, inclusive. It panics if max < min, see IntChecked.
 func (r *randomizer) Int(min int, max int) int {
-	res, err := secureInt(min, max)
+	if r.fast {
+		return fastInt(min, max)
+	}
+	res, err := readInt(r.src, min, max)
 	if err != nil {
-		log.Printf(
+		if r.strict {
+			panic(entropyError(err))
+		}
+		r.fellBack("Int", err)
+		r.logf(
 			"failed to use cryptographically secure random number generator for Int(%d, %d). Reason: %s",
 			min,
 			max,
@@ -51,7 +197,9 @@ func (r *randomizer) Int(min int, max int) int {
 		)
 		r.mtx.Lock()
 		defer r.mtx.Unlock()
-		return r.rnd.Intn(max-min+1) + min
+		// the fallback's Read never fails, and readInt handles spans that overflow an int
+		res, _ = readInt(r.rnd, min, max)
+		return res
 	}
 
 	return res
@@ -59,23 +207,37 @@ func (r *randomizer) Int(min int, max int) int {
 
 // returns n pseudo-random bytes
 func (r *randomizer) Bytes(n int) []byte {
-	res, err := secureBytes(n)
-	if err != nil { // fallback to math/rand
-		log.Printf(
-			"failed to use cryptographically secure random number generator for Bytes(%d). Reason: %s",
-			n,
+	b := make([]byte, n)
+	if err := r.fill(b, "Bytes"); err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// fills p from the entropy source, falling back to math/rand unless r is strict. method names the caller
+// in log messages.
+func (r *randomizer) fill(p []byte, method string) error {
+	if r.fast {
+		fastRead(p)
+		return nil
+	}
+	if _, err := io.ReadFull(r.src, p); err != nil { // fallback to math/rand
+		if r.strict {
+			return entropyError(err)
+		}
+		r.fellBack(method, err)
+		r.logf(
+			"failed to use cryptographically secure random number generator for %s(%d). Reason: %s",
+			method,
+			len(p),
 			err.Error(),
 		)
 		r.mtx.Lock()
 		defer r.mtx.Unlock()
-		b := make([]byte, n)
-		// returned error can be safely ignored as it cannot be non-nil
-		// ref https://golang.org/pkg/math/rand/#Read
-		r.rnd.Read(b)
-		return b
+		// returned error can be safely ignored as the fallback's Read never fails
+		r.rnd.Read(p)
 	}
-
-	return res
+	return nil
 }
 
 // returns pseudo-random bool
@@ -88,15 +250,195 @@ func (r *randomizer) Rune(pool []rune) rune {
 	return pool[r.Int(0, len(pool)-1)]
 }
 
-// returns string of pseudo-random runes from pool
-func (r *randomizer) String(length int, pool []rune) string {
-	out := make([]rune, 0)
+// configures String
+type StringOption func(*stringOptions)
+
+type stringOptions struct {
+	randomCase  bool
+	noRepeats   bool
+	maxRun      int
+	mustContain [][]rune
+}
+
+// makes String randomly flip the case of letters drawn from the pool, see RandomCase.
+// Case is flipped after generation, so the other options compare runes as drawn from the pool.
+func RandomCasing() StringOption {
+	return func(o *stringOptions) {
+		o.randomCase = true
+	}
+}
+
+// makes String never repeat a rune directly after itself, as in "aa"
+func NoConsecutiveRepeats() StringOption {
+	return func(o *stringOptions) {
+		o.noRepeats = true
+	}
+}
+
+// makes String avoid n or more consecutive runes in ascending or descending order, such as "abc" or "321"
+// for n = 3. Values of n below 2 are ignored.
+func NoSequentialRuns(n int) StringOption {
+	return func(o *stringOptions) {
+		if n >= 2 {
+			o.maxRun = n
+		}
+	}
+}
+
+// makes String include at least one rune from pool, e.g. MustContain(GetNumericPool()) for a digit.
+// It may be given multiple times, each pool occupies its own position of the string.
+func MustContain(pool []rune) StringOption {
+	return func(o *stringOptions) {
+		o.mustContain = append(o.mustContain, pool)
+	}
+}
+
+// returns string of pseudo-random runes from pool. Constraint options are enforced while generating
+// and it panics if they cannot be met, e.g. when length is shorter than the number of MustContain pools.
+// Pools of at least 4 runes always satisfy NoConsecutiveRepeats and NoSequentialRuns.
+func (r *randomizer) String(length int, pool []rune, opts ...StringOption) string {
+	var o stringOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var out []rune
+	if o.noRepeats || o.maxRun > 0 || len(o.mustContain) > 0 {
+		out = r.constrainedString(length, pool, &o)
+	} else {
+		out = make([]rune, 0)
+		for i := 0; i < length; i++ {
+			out = append(out, r.Rune(pool))
+		}
+	}
+	if o.randomCase {
+		r.randomCase(out)
+	}
+	return string(out)
+}
+
+func (r *randomizer) constrainedString(length int, pool []rune, o *stringOptions) []rune {
+	if len(o.mustContain) > length {
+		panic(fmt.Sprintf("String: length %d is too short for %d MustContain pools", length, len(o.mustContain)))
+	}
+	// every MustContain pool gets a distinct pseudo-random position
+	required := make(map[int][]rune, len(o.mustContain))
+	positions := make([]int, length)
+	for i := range positions {
+		positions[i] = i
+	}
+	for i, p := range o.mustContain {
+		j := r.Int(i, length-1)
+		positions[i], positions[j] = positions[j], positions[i]
+		required[positions[i]] = p
+	}
+
+	out := make([]rune, 0, length)
+	candidates := make([]rune, 0, len(pool))
 	for i := 0; i < length; i++ {
-		out = append(out, r.Rune(pool))
+		src := pool
+		if p, ok := required[i]; ok {
+			src = p
+		}
+		candidates = candidates[:0]
+		for _, c := range src {
+			if o.allows(out, c) {
+				candidates = append(candidates, c)
+			}
+		}
+		if len(candidates) == 0 {
+			panic(fmt.Sprintf("String: no rune of the pool satisfies the constraints at position %d", i))
+		}
+		out = append(out, candidates[r.Int(0, len(candidates)-1)])
+	}
+	return out
+}
+
+// reports whether c may follow out
+func (o *stringOptions) allows(out []rune, c rune) bool {
+	if len(out) == 0 {
+		return true
+	}
+	last := out[len(out)-1]
+	if o.noRepeats && last == c {
+		return false
+	}
+	if o.maxRun == 0 || len(out) < o.maxRun-1 {
+		return true
 	}
+	step := c - last
+	if step != 1 && step != -1 {
+		return true
+	}
+	for j := len(out) - 1; j > len(out)-o.maxRun+1; j-- {
+		if out[j]-out[j-1] != step {
+			return true
+		}
+	}
+	return false
+}
+
+// returns s with the case of every letter pseudo-randomly set to upper or lower case
+func (r *randomizer) RandomCase(s string) string {
+	out := []rune(s)
+	r.randomCase(out)
 	return string(out)
 }
 
+func (r *randomizer) randomCase(s []rune) {
+	for i, c := range s {
+		if !unicode.IsLetter(c) {
+			continue
+		}
+		if r.Bool() {
+			s[i] = unicode.ToUpper(c)
+		} else {
+			s[i] = unicode.ToLower(c)
+		}
+	}
+}
+
+// returns string of pseudo-random runes from pool with a pseudo-random length between minLen and maxLen,
+// inclusive. The bounds may be given in either order.
+func (r *randomizer) StringBetween(minLen int, maxLen int, pool []rune) string {
+	if minLen > maxLen {
+		minLen, maxLen = maxLen, minLen
+	}
+	return r.String(r.Int(minLen, maxLen), pool)
+}
+
+// returns single pseudo-random rune from the runes of pool
+func (r *randomizer) RuneFromString(pool string) rune {
+	return r.Rune(runePool(pool))
+}
+
+// returns string of pseudo-random runes from the runes of pool
+func (r *randomizer) StringFromPool(length int, pool string) string {
+	return r.String(length, runePool(pool))
+}
+
+// []rune conversions of string pools, pools are usually literals so only a bounded number is kept
+var (
+	runePools     sync.Map
+	runePoolCount atomic.Int32
+)
+
+const maxRunePools = 256
+
+// returns pool as []rune, converting each distinct pool only once. The result must not be modified.
+func runePool(pool string) []rune {
+	if cached, ok := runePools.Load(pool); ok {
+		return cached.([]rune)
+	}
+	runes := []rune(pool)
+	if runePoolCount.Load() < maxRunePools {
+		if _, loaded := runePools.LoadOrStore(pool, runes); !loaded {
+			runePoolCount.Add(1)
+		}
+	}
+	return runes
+}
+
 // returns []rune of 0-9
 func GetNumericPool() []rune {
 	return []rune{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9'}
@@ -134,6 +476,38 @@ func GetAlphabeticLowercasePool() []rune {
 	}
 }
 
+// returns []rune of A-Z
+func GetAlphabeticUppercasePool() []rune {
+	return []rune{
+		'A',
+		'B',
+		'C',
+		'D',
+		'E',
+		'F',
+		'G',
+		'H',
+		'I',
+		'J',
+		'K',
+		'L',
+		'M',
+		'N',
+		'O',
+		'P',
+		'Q',
+		'R',
+		'S',
+		'T',
+		'U',
+		'V',
+		'W',
+		'X',
+		'Y',
+		'Z',
+	}
+}
+
 // returns []rune a-z A-Z
 func GetAlphabeticPool() []rune {
 	return []rune{
@@ -475,19 +849,53 @@ func GetUnambiguousPool() []rune {
 	}
 }
 
-// returns cryptographically secure int between min and max, inclusive
-func secureInt(min int, max int) (int, error) {
-	nBig, err := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(max-min+1)))
-	if err != nil {
+// returns int between min and max, inclusive, read from src. It panics if max < min.
+func readInt(src io.Reader, min int, max int) (int, error) {
+	if max < min {
+		panic(fmt.Sprintf("invalid argument to Int: max < min (min %d, max %d)", min, max))
+	}
+	// the span may exceed the int range, but always fits a uint64 and only wraps to 0 for all 64 bit ints
+	n := uint64(max) - uint64(min) + 1
+	if n == 0 {
+		v, err := readUint64(src)
+		return int(v), err
+	}
+	// values below 2^64 mod n are rejected so that every remainder is equally likely
+	limit := -n % n
+	for {
+		v, err := readUint64(src)
+		if err != nil {
+			return 0, err
+		}
+		if v >= limit {
+			return min + int(v%n), nil
+		}
+	}
+}
+
+// implemented by sources that produce random uint64 values without going through a byte slice
+type uint64Source interface {
+	Uint64() uint64
+}
+
+func readUint64(src io.Reader) (uint64, error) {
+	if s, ok := src.(uint64Source); ok {
+		return s.Uint64(), nil
+	}
+	if u, ok := src.(uint64Reader); ok {
+		return u.uint64()
+	}
+	var b [8]byte
+	if _, err := io.ReadFull(src, b[:]); err != nil {
 		return 0, err
 	}
-	return int(nBig.Int64()) + min, nil
+	return binary.LittleEndian.Uint64(b[:]), nil
 }
 
-// returns n cryptographically secure bytes
-func secureBytes(n int) ([]byte, error) {
+// returns n bytes read from src
+func readBytes(src io.Reader, n int) ([]byte, error) {
 	b := make([]byte, n)
-	_, err := cryptorand.Read(b)
+	_, err := io.ReadFull(src, b)
 	if err != nil {
 		return b, err
 	}
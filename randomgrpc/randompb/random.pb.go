@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: randompb/random.proto
+
+package randompb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IntRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           int64                  `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           int64                  `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntRequest) Reset() {
+	*x = IntRequest{}
+	mi := &file_randompb_random_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntRequest) ProtoMessage() {}
+
+func (x *IntRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntRequest.ProtoReflect.Descriptor instead.
+func (*IntRequest) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IntRequest) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *IntRequest) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type IntResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntResponse) Reset() {
+	*x = IntResponse{}
+	mi := &file_randompb_random_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntResponse) ProtoMessage() {}
+
+func (x *IntResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntResponse.ProtoReflect.Descriptor instead.
+func (*IntResponse) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IntResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type BytesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	N             uint32                 `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BytesRequest) Reset() {
+	*x = BytesRequest{}
+	mi := &file_randompb_random_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesRequest) ProtoMessage() {}
+
+func (x *BytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesRequest.ProtoReflect.Descriptor instead.
+func (*BytesRequest) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BytesRequest) GetN() uint32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type BytesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BytesResponse) Reset() {
+	*x = BytesResponse{}
+	mi := &file_randompb_random_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BytesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesResponse) ProtoMessage() {}
+
+func (x *BytesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesResponse.ProtoReflect.Descriptor instead.
+func (*BytesResponse) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BytesResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type BoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoolRequest) Reset() {
+	*x = BoolRequest{}
+	mi := &file_randompb_random_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolRequest) ProtoMessage() {}
+
+func (x *BoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolRequest.ProtoReflect.Descriptor instead.
+func (*BoolRequest) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{4}
+}
+
+type BoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoolResponse) Reset() {
+	*x = BoolResponse{}
+	mi := &file_randompb_random_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolResponse) ProtoMessage() {}
+
+func (x *BoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolResponse.ProtoReflect.Descriptor instead.
+func (*BoolResponse) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BoolResponse) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// pool is interpreted as a sequence of unicode code points
+type RuneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pool          string                 `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuneRequest) Reset() {
+	*x = RuneRequest{}
+	mi := &file_randompb_random_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneRequest) ProtoMessage() {}
+
+func (x *RuneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuneRequest.ProtoReflect.Descriptor instead.
+func (*RuneRequest) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RuneRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+type RuneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuneResponse) Reset() {
+	*x = RuneResponse{}
+	mi := &file_randompb_random_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneResponse) ProtoMessage() {}
+
+func (x *RuneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuneResponse.ProtoReflect.Descriptor instead.
+func (*RuneResponse) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RuneResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// pool is interpreted as a sequence of unicode code points
+type StringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        uint32                 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Pool          string                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringRequest) Reset() {
+	*x = StringRequest{}
+	mi := &file_randompb_random_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringRequest) ProtoMessage() {}
+
+func (x *StringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringRequest.ProtoReflect.Descriptor instead.
+func (*StringRequest) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StringRequest) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *StringRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+type StringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringResponse) Reset() {
+	*x = StringResponse{}
+	mi := &file_randompb_random_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringResponse) ProtoMessage() {}
+
+func (x *StringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_randompb_random_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringResponse.ProtoReflect.Descriptor instead.
+func (*StringResponse) Descriptor() ([]byte, []int) {
+	return file_randompb_random_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StringResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_randompb_random_proto protoreflect.FileDescriptor
+
+const file_randompb_random_proto_rawDesc = "" +
+	"\n" +
+	"\x15randompb/random.proto\x12\trandom.v1\"0\n" +
+	"\n" +
+	"IntRequest\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x03R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x03R\x03max\"#\n" +
+	"\vIntResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"\x1c\n" +
+	"\fBytesRequest\x12\f\n" +
+	"\x01n\x18\x01 \x01(\rR\x01n\"%\n" +
+	"\rBytesResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05value\"\r\n" +
+	"\vBoolRequest\"$\n" +
+	"\fBoolResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\"!\n" +
+	"\vRuneRequest\x12\x12\n" +
+	"\x04pool\x18\x01 \x01(\tR\x04pool\"$\n" +
+	"\fRuneResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\";\n" +
+	"\rStringRequest\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\rR\x06length\x12\x12\n" +
+	"\x04pool\x18\x02 \x01(\tR\x04pool\"&\n" +
+	"\x0eStringResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value2\xab\x02\n" +
+	"\x06Random\x124\n" +
+	"\x03Int\x12\x15.random.v1.IntRequest\x1a\x16.random.v1.IntResponse\x12:\n" +
+	"\x05Bytes\x12\x17.random.v1.BytesRequest\x1a\x18.random.v1.BytesResponse\x127\n" +
+	"\x04Bool\x12\x16.random.v1.BoolRequest\x1a\x17.random.v1.BoolResponse\x127\n" +
+	"\x04Rune\x12\x16.random.v1.RuneRequest\x1a\x17.random.v1.RuneResponse\x12=\n" +
+	"\x06String\x12\x18.random.v1.StringRequest\x1a\x19.random.v1.StringResponseB/Z-github.com/h4ckitt/random/randomgrpc/randompbb\x06proto3"
+
+var (
+	file_randompb_random_proto_rawDescOnce sync.Once
+	file_randompb_random_proto_rawDescData []byte
+)
+
+func file_randompb_random_proto_rawDescGZIP() []byte {
+	file_randompb_random_proto_rawDescOnce.Do(func() {
+		file_randompb_random_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_randompb_random_proto_rawDesc), len(file_randompb_random_proto_rawDesc)))
+	})
+	return file_randompb_random_proto_rawDescData
+}
+
+var file_randompb_random_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_randompb_random_proto_goTypes = []any{
+	(*IntRequest)(nil),     // 0: random.v1.IntRequest
+	(*IntResponse)(nil),    // 1: random.v1.IntResponse
+	(*BytesRequest)(nil),   // 2: random.v1.BytesRequest
+	(*BytesResponse)(nil),  // 3: random.v1.BytesResponse
+	(*BoolRequest)(nil),    // 4: random.v1.BoolRequest
+	(*BoolResponse)(nil),   // 5: random.v1.BoolResponse
+	(*RuneRequest)(nil),    // 6: random.v1.RuneRequest
+	(*RuneResponse)(nil),   // 7: random.v1.RuneResponse
+	(*StringRequest)(nil),  // 8: random.v1.StringRequest
+	(*StringResponse)(nil), // 9: random.v1.StringResponse
+}
+var file_randompb_random_proto_depIdxs = []int32{
+	0, // 0: random.v1.Random.Int:input_type -> random.v1.IntRequest
+	2, // 1: random.v1.Random.Bytes:input_type -> random.v1.BytesRequest
+	4, // 2: random.v1.Random.Bool:input_type -> random.v1.BoolRequest
+	6, // 3: random.v1.Random.Rune:input_type -> random.v1.RuneRequest
+	8, // 4: random.v1.Random.String:input_type -> random.v1.StringRequest
+	1, // 5: random.v1.Random.Int:output_type -> random.v1.IntResponse
+	3, // 6: random.v1.Random.Bytes:output_type -> random.v1.BytesResponse
+	5, // 7: random.v1.Random.Bool:output_type -> random.v1.BoolResponse
+	7, // 8: random.v1.Random.Rune:output_type -> random.v1.RuneResponse
+	9, // 9: random.v1.Random.String:output_type -> random.v1.StringResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_randompb_random_proto_init() }
+func file_randompb_random_proto_init() {
+	if File_randompb_random_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_randompb_random_proto_rawDesc), len(file_randompb_random_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_randompb_random_proto_goTypes,
+		DependencyIndexes: file_randompb_random_proto_depIdxs,
+		MessageInfos:      file_randompb_random_proto_msgTypes,
+	}.Build()
+	File_randompb_random_proto = out.File
+	file_randompb_random_proto_goTypes = nil
+	file_randompb_random_proto_depIdxs = nil
+}
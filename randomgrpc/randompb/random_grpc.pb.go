@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: randompb/random.proto
+
+package randompb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Random_Int_FullMethodName    = "/random.v1.Random/Int"
+	Random_Bytes_FullMethodName  = "/random.v1.Random/Bytes"
+	Random_Bool_FullMethodName   = "/random.v1.Random/Bool"
+	Random_Rune_FullMethodName   = "/random.v1.Random/Rune"
+	Random_String_FullMethodName = "/random.v1.Random/String"
+)
+
+// RandomClient is the client API for Random service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// exposes the SFRand generator methods to non-Go clients
+type RandomClient interface {
+	// returns pseudo-random int between min and max, inclusive
+	Int(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*IntResponse, error)
+	// returns n pseudo-random bytes
+	Bytes(ctx context.Context, in *BytesRequest, opts ...grpc.CallOption) (*BytesResponse, error)
+	// returns pseudo-random bool
+	Bool(ctx context.Context, in *BoolRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	// returns single pseudo-random rune from pool
+	Rune(ctx context.Context, in *RuneRequest, opts ...grpc.CallOption) (*RuneResponse, error)
+	// returns string of pseudo-random runes from pool
+	String(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error)
+}
+
+type randomClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRandomClient(cc grpc.ClientConnInterface) RandomClient {
+	return &randomClient{cc}
+}
+
+func (c *randomClient) Int(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*IntResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntResponse)
+	err := c.cc.Invoke(ctx, Random_Int_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomClient) Bytes(ctx context.Context, in *BytesRequest, opts ...grpc.CallOption) (*BytesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesResponse)
+	err := c.cc.Invoke(ctx, Random_Bytes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomClient) Bool(ctx context.Context, in *BoolRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Random_Bool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomClient) Rune(ctx context.Context, in *RuneRequest, opts ...grpc.CallOption) (*RuneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuneResponse)
+	err := c.cc.Invoke(ctx, Random_Rune_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomClient) String(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, Random_String_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RandomServer is the server API for Random service.
+// All implementations must embed UnimplementedRandomServer
+// for forward compatibility.
+//
+// exposes the SFRand generator methods to non-Go clients
+type RandomServer interface {
+	// returns pseudo-random int between min and max, inclusive
+	Int(context.Context, *IntRequest) (*IntResponse, error)
+	// returns n pseudo-random bytes
+	Bytes(context.Context, *BytesRequest) (*BytesResponse, error)
+	// returns pseudo-random bool
+	Bool(context.Context, *BoolRequest) (*BoolResponse, error)
+	// returns single pseudo-random rune from pool
+	Rune(context.Context, *RuneRequest) (*RuneResponse, error)
+	// returns string of pseudo-random runes from pool
+	String(context.Context, *StringRequest) (*StringResponse, error)
+	mustEmbedUnimplementedRandomServer()
+}
+
+// UnimplementedRandomServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRandomServer struct{}
+
+func (UnimplementedRandomServer) Int(context.Context, *IntRequest) (*IntResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Int not implemented")
+}
+func (UnimplementedRandomServer) Bytes(context.Context, *BytesRequest) (*BytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bytes not implemented")
+}
+func (UnimplementedRandomServer) Bool(context.Context, *BoolRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bool not implemented")
+}
+func (UnimplementedRandomServer) Rune(context.Context, *RuneRequest) (*RuneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rune not implemented")
+}
+func (UnimplementedRandomServer) String(context.Context, *StringRequest) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method String not implemented")
+}
+func (UnimplementedRandomServer) mustEmbedUnimplementedRandomServer() {}
+func (UnimplementedRandomServer) testEmbeddedByValue()                {}
+
+// UnsafeRandomServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RandomServer will
+// result in compilation errors.
+type UnsafeRandomServer interface {
+	mustEmbedUnimplementedRandomServer()
+}
+
+func RegisterRandomServer(s grpc.ServiceRegistrar, srv RandomServer) {
+	// If the following call pancis, it indicates UnimplementedRandomServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Random_ServiceDesc, srv)
+}
+
+func _Random_Int_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServer).Int(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Random_Int_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServer).Int(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Random_Bytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServer).Bytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Random_Bytes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServer).Bytes(ctx, req.(*BytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Random_Bool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServer).Bool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Random_Bool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServer).Bool(ctx, req.(*BoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Random_Rune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServer).Rune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Random_Rune_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServer).Rune(ctx, req.(*RuneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Random_String_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServer).String(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Random_String_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServer).String(ctx, req.(*StringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Random_ServiceDesc is the grpc.ServiceDesc for Random service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Random_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "random.v1.Random",
+	HandlerType: (*RandomServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Int",
+			Handler:    _Random_Int_Handler,
+		},
+		{
+			MethodName: "Bytes",
+			Handler:    _Random_Bytes_Handler,
+		},
+		{
+			MethodName: "Bool",
+			Handler:    _Random_Bool_Handler,
+		},
+		{
+			MethodName: "Rune",
+			Handler:    _Random_Rune_Handler,
+		},
+		{
+			MethodName: "String",
+			Handler:    _Random_String_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "randompb/random.proto",
+}
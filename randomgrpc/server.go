@@ -0,0 +1,128 @@
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative randompb/random.proto
+
+package randomgrpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"unicode/utf8"
+
+	"github.com/h4ckitt/random"
+	"github.com/h4ckitt/random/randomgrpc/randompb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// upper bound on the number of bytes a single Bytes call may request
+	maxBytes = 1 << 20
+	// upper bound on the number of runes a single String call may request
+	maxStringLength = 1 << 16
+)
+
+// Server implements randompb.RandomServer on top of an SFRand. It never serves values from the math/rand
+// fallback: it only calls the E variants of SFRand's methods, so requests fail with codes.Unavailable when the
+// entropy source does.
+type Server struct {
+	randompb.UnimplementedRandomServer
+	rnd random.SFRand
+}
+
+// returns a Server backed by rnd, or by NewSFRand(WithFallbackDisabled()) if rnd is nil. rnd should be created
+// with NewSFRandStrict or WithFallbackDisabled. Randomizers whose values clients could predict, see
+// random.IsInsecure, are refused with an error wrapping random.ErrInsecureSource.
+func NewServer(rnd random.SFRand) (*Server, error) {
+	if rnd == nil {
+		rnd = random.NewSFRand(random.WithFallbackDisabled())
+	}
+	if random.IsInsecure(rnd) {
+		return nil, fmt.Errorf("randomgrpc: %w", random.ErrInsecureSource)
+	}
+	return &Server{rnd: rnd}, nil
+}
+
+func (s *Server) Int(_ context.Context, req *randompb.IntRequest) (*randompb.IntResponse, error) {
+	min, max := req.GetMin(), req.GetMax()
+	if min > max {
+		return nil, status.Errorf(codes.InvalidArgument, "min (%d) must not be greater than max (%d)", min, max)
+	}
+	if int64(int(min)) != min || int64(int(max)) != max {
+		return nil, status.Errorf(codes.InvalidArgument, "range [%d, %d] does not fit in the server's int", min, max)
+	}
+	if span := max - min; span < 0 || span >= math.MaxInt64 || int64(int(span+1)) != span+1 {
+		return nil, status.Errorf(codes.InvalidArgument, "range [%d, %d] is too wide", min, max)
+	}
+	v, err := s.rnd.IntE(int(min), int(max))
+	if err != nil {
+		return nil, entropyStatus(err)
+	}
+	return &randompb.IntResponse{Value: int64(v)}, nil
+}
+
+func (s *Server) Bytes(_ context.Context, req *randompb.BytesRequest) (*randompb.BytesResponse, error) {
+	if req.GetN() > maxBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "n (%d) exceeds the limit of %d bytes", req.GetN(), maxBytes)
+	}
+	v, err := s.rnd.BytesE(int(req.GetN()))
+	if err != nil {
+		return nil, entropyStatus(err)
+	}
+	return &randompb.BytesResponse{Value: v}, nil
+}
+
+func (s *Server) Bool(_ context.Context, _ *randompb.BoolRequest) (*randompb.BoolResponse, error) {
+	v, err := s.rnd.IntE(0, 1)
+	if err != nil {
+		return nil, entropyStatus(err)
+	}
+	return &randompb.BoolResponse{Value: v == 1}, nil
+}
+
+func (s *Server) Rune(_ context.Context, req *randompb.RuneRequest) (*randompb.RuneResponse, error) {
+	pool, err := poolFromString(req.GetPool())
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.rnd.RuneE(pool)
+	if err != nil {
+		return nil, entropyStatus(err)
+	}
+	return &randompb.RuneResponse{Value: string(v)}, nil
+}
+
+func (s *Server) String(_ context.Context, req *randompb.StringRequest) (*randompb.StringResponse, error) {
+	if req.GetLength() > maxStringLength {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"length (%d) exceeds the limit of %d runes",
+			req.GetLength(),
+			maxStringLength,
+		)
+	}
+	pool, err := poolFromString(req.GetPool())
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.rnd.StringE(int(req.GetLength()), pool)
+	if err != nil {
+		return nil, entropyStatus(err)
+	}
+	return &randompb.StringResponse{Value: v}, nil
+}
+
+// reports a failed entropy source as Unavailable rather than serving values from a fallback generator
+func entropyStatus(err error) error {
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// converts a wire pool into []rune, rejecting pools the generator cannot draw from
+func poolFromString(pool string) ([]rune, error) {
+	if pool == "" {
+		return nil, status.Error(codes.InvalidArgument, "pool must not be empty")
+	}
+	if !utf8.ValidString(pool) {
+		return nil, status.Error(codes.InvalidArgument, "pool must be valid UTF-8")
+	}
+	return []rune(pool), nil
+}
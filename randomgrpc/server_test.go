@@ -0,0 +1,58 @@
+package randomgrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/h4ckitt/random"
+	"github.com/h4ckitt/random/randomgrpc/randompb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewServerInsecure(t *testing.T) {
+	for name, rnd := range map[string]random.SFRand{
+		"seeded":    random.NewSeededSFRand(1),
+		"fast":      random.NewFastInsecure(),
+		"with seed": random.NewSFRand(random.WithSeed(1)),
+	} {
+		if s, err := NewServer(rnd); !errors.Is(err, random.ErrInsecureSource) {
+			t.Errorf("NewServer(%s) = %v, %v, want ErrInsecureSource", name, s, err)
+		}
+	}
+	for name, rnd := range map[string]random.SFRand{
+		"nil":    nil,
+		"strict": random.NewSFRand(random.WithFallbackDisabled()),
+	} {
+		if _, err := NewServer(rnd); err != nil {
+			t.Errorf("NewServer(%s): %v", name, err)
+		}
+	}
+}
+
+func TestServerInt(t *testing.T) {
+	s, err := NewServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		min, max int64
+		code     codes.Code
+	}{
+		{1, 0, codes.InvalidArgument},
+		{-1, -2, codes.InvalidArgument},
+		{-1 << 63, 1<<63 - 1, codes.InvalidArgument},
+		{5, 5, codes.OK},
+		{-10, 10, codes.OK},
+	} {
+		res, err := s.Int(context.Background(), &randompb.IntRequest{Min: tc.min, Max: tc.max})
+		if got := status.Code(err); got != tc.code {
+			t.Errorf("Int(%d, %d) code = %v, want %v", tc.min, tc.max, got, tc.code)
+			continue
+		}
+		if err == nil && (res.GetValue() < tc.min || res.GetValue() > tc.max) {
+			t.Errorf("Int(%d, %d) = %d", tc.min, tc.max, res.GetValue())
+		}
+	}
+}
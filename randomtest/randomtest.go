@@ -0,0 +1,150 @@
+package randomtest
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/h4ckitt/random"
+)
+
+var _ random.SFRand = (*MockSFRand)(nil)
+
+// Call records a single call of one of the scripted methods of a MockSFRand
+type Call struct {
+	Method string
+	Args   []any
+}
+
+func (c Call) String() string {
+	return fmt.Sprintf("%s%v", c.Method, c.Args)
+}
+
+// MockSFRand is an SFRand for unit tests whose Int, Bytes, Bool, Rune and String return pre-programmed
+// values in the order they were queued, regardless of their arguments, and record every call, e.g.
+//
+//	m := randomtest.NewMockSFRand(nil).QueueInt(4).QueueString("abc")
+//	code := newCouponCode(m) // code under test
+//	// assert on code and m.Calls()
+//
+// Calling a scripted method with an empty queue, or any other method, delegates to the embedded SFRand
+// and panics if there is none. Methods of the embedded SFRand do not call back into the mock, so e.g.
+// its StringBetween does not consume queued Int values. MockSFRand is safe for concurrent use.
+type MockSFRand struct {
+	random.SFRand
+
+	mtx     sync.Mutex
+	ints    []int
+	bytes   [][]byte
+	bools   []bool
+	runes   []rune
+	strings []string
+	calls   []Call
+}
+
+// returns MockSFRand delegating unscripted calls to fallback, which may be nil to make them panic instead
+func NewMockSFRand(fallback random.SFRand) *MockSFRand {
+	return &MockSFRand{SFRand: fallback}
+}
+
+// queues values returned by Int
+func (m *MockSFRand) QueueInt(v ...int) *MockSFRand {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.ints = append(m.ints, v...)
+	return m
+}
+
+// queues values returned by Bytes
+func (m *MockSFRand) QueueBytes(v ...[]byte) *MockSFRand {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.bytes = append(m.bytes, v...)
+	return m
+}
+
+// queues values returned by Bool
+func (m *MockSFRand) QueueBool(v ...bool) *MockSFRand {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.bools = append(m.bools, v...)
+	return m
+}
+
+// queues values returned by Rune
+func (m *MockSFRand) QueueRune(v ...rune) *MockSFRand {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.runes = append(m.runes, v...)
+	return m
+}
+
+// queues values returned by String
+func (m *MockSFRand) QueueString(v ...string) *MockSFRand {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.strings = append(m.strings, v...)
+	return m
+}
+
+// returns the recorded calls of scripted methods in the order they were made
+func (m *MockSFRand) Calls() []Call {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *MockSFRand) Int(min int, max int) int {
+	if v, ok := next(m, &m.ints, Call{Method: "Int", Args: []any{min, max}}); ok {
+		return v
+	}
+	return m.fallback("Int").Int(min, max)
+}
+
+func (m *MockSFRand) Bytes(n int) []byte {
+	if v, ok := next(m, &m.bytes, Call{Method: "Bytes", Args: []any{n}}); ok {
+		return v
+	}
+	return m.fallback("Bytes").Bytes(n)
+}
+
+func (m *MockSFRand) Bool() bool {
+	if v, ok := next(m, &m.bools, Call{Method: "Bool"}); ok {
+		return v
+	}
+	return m.fallback("Bool").Bool()
+}
+
+func (m *MockSFRand) Rune(pool []rune) rune {
+	if v, ok := next(m, &m.runes, Call{Method: "Rune", Args: []any{pool}}); ok {
+		return v
+	}
+	return m.fallback("Rune").Rune(pool)
+}
+
+func (m *MockSFRand) String(length int, pool []rune, opts ...random.StringOption) string {
+	if v, ok := next(m, &m.strings, Call{Method: "String", Args: []any{length, pool}}); ok {
+		return v
+	}
+	return m.fallback("String").String(length, pool, opts...)
+}
+
+// records call and pops the first value of queue, if any
+func next[T any](m *MockSFRand, queue *[]T, call Call) (T, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.calls = append(m.calls, call)
+	var zero T
+	if len(*queue) == 0 {
+		return zero, false
+	}
+	v := (*queue)[0]
+	*queue = (*queue)[1:]
+	return v, true
+}
+
+func (m *MockSFRand) fallback(method string) random.SFRand {
+	if m.SFRand == nil {
+		panic(fmt.Sprintf("randomtest: no value queued for %s and no fallback SFRand", method))
+	}
+	return m.SFRand
+}
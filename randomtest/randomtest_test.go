@@ -0,0 +1,118 @@
+package randomtest
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/h4ckitt/random"
+)
+
+func TestMockQueues(t *testing.T) {
+	m := NewMockSFRand(nil).
+		QueueInt(4, 2).
+		QueueBytes([]byte{1, 2}).
+		QueueBool(true, false).
+		QueueRune('x').
+		QueueString("abc")
+
+	if got := m.Int(0, 10); got != 4 {
+		t.Errorf("first Int = %d, want 4", got)
+	}
+	if got := m.String(3, []rune("xyz")); got != "abc" {
+		t.Errorf("String = %q, want abc", got)
+	}
+	if got := m.Int(-5, 5); got != 2 {
+		t.Errorf("second Int = %d, want 2", got)
+	}
+	if got := m.Bytes(2); !bytes.Equal(got, []byte{1, 2}) {
+		t.Errorf("Bytes = %v, want [1 2]", got)
+	}
+	if got := m.Bool(); !got {
+		t.Error("first Bool = false, want true")
+	}
+	if got := m.Bool(); got {
+		t.Error("second Bool = true, want false")
+	}
+	if got := m.Rune([]rune("ab")); got != 'x' {
+		t.Errorf("Rune = %q, want x", got)
+	}
+
+	want := []Call{
+		{"Int", []any{0, 10}},
+		{"String", []any{3, []rune("xyz")}},
+		{"Int", []any{-5, 5}},
+		{"Bytes", []any{2}},
+		{"Bool", nil},
+		{"Bool", nil},
+		{"Rune", []any{[]rune("ab")}},
+	}
+	if got := m.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Calls() = %v, want %v", got, want)
+	}
+	if got := want[0].String(); got != "Int[0 10]" {
+		t.Errorf("Call.String() = %q, want %q", got, "Int[0 10]")
+	}
+}
+
+func TestMockFallback(t *testing.T) {
+	m := NewMockSFRand(random.NewSFRand()).QueueInt(7)
+	if got := m.Int(0, 100); got != 7 {
+		t.Errorf("queued Int = %d, want 7", got)
+	}
+	for i := 0; i < 100; i++ {
+		if got := m.Int(10, 12); got < 10 || got > 12 {
+			t.Fatalf("fallback Int(10, 12) = %d", got)
+		}
+	}
+	if got := m.String(8, random.GetAlphaNumericPool()); len(got) != 8 {
+		t.Errorf("fallback String(8) = %q", got)
+	}
+	// unscripted methods go to the embedded SFRand
+	if got := m.UUID(); len(got) != 36 {
+		t.Errorf("UUID() = %q", got)
+	}
+	if n := len(m.Calls()); n != 102 {
+		t.Errorf("recorded %d calls, want 102 including the fallback ones", n)
+	}
+}
+
+func TestMockEmptyQueuePanics(t *testing.T) {
+	m := NewMockSFRand(nil).QueueInt(1)
+	m.Int(0, 1)
+	defer func() {
+		v := recover()
+		if s, ok := v.(string); !ok || !strings.Contains(s, "no value queued for Int") {
+			t.Errorf("panic = %v, want message about the empty Int queue", v)
+		}
+	}()
+	m.Int(0, 1)
+}
+
+func TestMockConcurrent(t *testing.T) {
+	m := NewMockSFRand(nil)
+	for i := 0; i < 100; i++ {
+		m.QueueInt(i)
+	}
+	var wg sync.WaitGroup
+	var mtx sync.Mutex
+	seen := map[int]bool{}
+	for g := 0; g < 10; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 10; i++ {
+				v := m.Int(0, 0)
+				mtx.Lock()
+				seen[v] = true
+				mtx.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(seen) != 100 {
+		t.Errorf("%d distinct queued values returned, want each of the 100 exactly once", len(seen))
+	}
+}
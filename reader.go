@@ -0,0 +1,24 @@
+package random
+
+import "io"
+
+// returns io.Reader of pseudo-random bytes, e.g. for rsa.GenerateKey or anything else expecting an entropy
+// reader. Reading from it is the same as calling Read.
+func (r *randomizer) Reader() io.Reader {
+	return r
+}
+
+// fills p with pseudo-random bytes and returns len(p), reusing the caller's buffer instead of allocating like
+// Bytes. It applies the same fallback policy as Bytes, except that a strict SFRand returns the error wrapping
+// ErrEntropyUnavailable instead of panicking.
+func (r *randomizer) Read(p []byte) (int, error) {
+	if err := r.fill(p, "Read"); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// fills p with pseudo-random bytes, see Read
+func (r *randomizer) Fill(p []byte) error {
+	return r.fill(p, "Fill")
+}
@@ -0,0 +1,145 @@
+package random
+
+import (
+	"container/heap"
+	"math"
+)
+
+// keeps a weighted random sample of at most k items of a stream of unknown length: every item ends up
+// in the sample with probability proportional to its weight, as in sampling without replacement.
+// It implements Efraimidis and Spirakis' A-ExpJ algorithm, which draws random numbers only for the items
+// that enter the sample. It is not safe for concurrent use.
+type WeightedReservoir[T any] struct {
+	r     SFRand
+	k     int
+	items keyedItems[T]
+	// remaining weight to skip before the next item enters the sample
+	skip float64
+}
+
+type keyedItem[T any] struct {
+	item T
+	// logarithm of the item's A-Res key u^(1/weight), kept in log space to avoid underflow for small weights
+	key float64
+}
+
+// min-heap of items by key
+type keyedItems[T any] []keyedItem[T]
+
+func (h keyedItems[T]) Len() int           { return len(h) }
+func (h keyedItems[T]) Less(i, j int) bool { return h[i].key < h[j].key }
+func (h keyedItems[T]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *keyedItems[T]) Push(x any)        { *h = append(*h, x.(keyedItem[T])) }
+func (h *keyedItems[T]) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// returns WeightedReservoir keeping a sample of at most k items drawn with r. It panics if k < 1.
+func NewWeightedReservoir[T any](r SFRand, k int) *WeightedReservoir[T] {
+	if k < 1 {
+		panic("NewWeightedReservoir: k must be at least 1")
+	}
+	return &WeightedReservoir[T]{r: r, k: k, items: make(keyedItems[T], 0, k)}
+}
+
+// offers item with the given weight to the sample. Items with a weight <= 0 are never sampled.
+func (w *WeightedReservoir[T]) Add(item T, weight float64) {
+	if !(weight > 0) {
+		return
+	}
+	if len(w.items) < w.k {
+		heap.Push(&w.items, keyedItem[T]{item: item, key: math.Log(openUnitFloat(w.r)) / weight})
+		if len(w.items) == w.k {
+			w.skip = w.nextSkip()
+		}
+		return
+	}
+
+	w.skip -= weight
+	if w.skip > 0 {
+		return
+	}
+	// the new key is drawn from (t, 1) where t = threshold^weight, so it beats the current minimum
+	t := math.Exp(w.items[0].key * weight)
+	u := t + openUnitFloat(w.r)*(1-t)
+	w.items[0] = keyedItem[T]{item: item, key: math.Log(u) / weight}
+	heap.Fix(&w.items, 0)
+	w.skip = w.nextSkip()
+}
+
+// returns the sampled items in no particular order
+func (w *WeightedReservoir[T]) Sample() []T {
+	out := make([]T, len(w.items))
+	for i, it := range w.items {
+		out[i] = it.item
+	}
+	return out
+}
+
+// returns weight to skip before the next item replaces the current minimum
+func (w *WeightedReservoir[T]) nextSkip() float64 {
+	return math.Log(openUnitFloat(w.r)) / w.items[0].key
+}
+
+// returns pseudo-random float64 in the open interval (0,1)
+func openUnitFloat(r SFRand) float64 {
+	return (float64(r.Int63n(1<<53)) + 0.5) / (1 << 53)
+}
+
+// keeps a uniform random sample of at most k items of a stream of unknown length, e.g. log lines or queue
+// messages, without buffering the stream. It implements Li's Algorithm L, which draws random numbers only for
+// the items that enter the sample. It is not safe for concurrent use.
+type Reservoir[T any] struct {
+	r     SFRand
+	items []T
+	seen  uint64
+	// number of items seen when the next one enters the sample, and Algorithm L's W
+	next uint64
+	w    float64
+}
+
+// returns Reservoir keeping a sample of at most k items drawn with r. It panics if k < 1.
+func NewReservoir[T any](r SFRand, k int) *Reservoir[T] {
+	if k < 1 {
+		panic("NewReservoir: k must be at least 1")
+	}
+	return &Reservoir[T]{r: r, items: make([]T, 0, k)}
+}
+
+// offers item to the sample
+func (s *Reservoir[T]) Add(item T) {
+	s.seen++
+	k := cap(s.items)
+	if len(s.items) < k {
+		s.items = append(s.items, item)
+		if len(s.items) == k {
+			s.w = math.Exp(math.Log(openUnitFloat(s.r)) / float64(k))
+			s.skip()
+		}
+		return
+	}
+	if s.seen != s.next {
+		return
+	}
+	s.items[s.r.Int(0, k-1)] = item
+	s.w *= math.Exp(math.Log(openUnitFloat(s.r)) / float64(k))
+	s.skip()
+}
+
+// returns the sampled items in no particular order
+func (s *Reservoir[T]) Sample() []T {
+	return append([]T(nil), s.items...)
+}
+
+// picks the next item to enter the sample
+func (s *Reservoir[T]) skip() {
+	gap := math.Floor(math.Log(openUnitFloat(s.r))/math.Log1p(-s.w)) + 1
+	if gap >= math.MaxUint64-float64(s.seen) {
+		s.next = math.MaxUint64
+		return
+	}
+	s.next = s.seen + uint64(gap)
+}
@@ -0,0 +1,140 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// fails t unless count is within five standard deviations of a binomial count of trials with probability p
+func checkBinomial(t *testing.T, what string, count int, trials int, p float64) {
+	t.Helper()
+	want := float64(trials) * p
+	if math.Abs(float64(count)-want) > 5*math.Sqrt(want*(1-p))+1e-9 {
+		t.Errorf("%s: %d of %d, want about %.0f", what, count, trials, want)
+	}
+}
+
+func TestWeightedReservoirProportional(t *testing.T) {
+	r := NewSFRand()
+	weights := []float64{1, 2, 3, 4, 0, -1}
+	const trials = 20000
+	counts := make([]int, len(weights))
+	for i := 0; i < trials; i++ {
+		res := NewWeightedReservoir[int](r, 1)
+		for item, w := range weights {
+			res.Add(item, w)
+		}
+		sample := res.Sample()
+		if len(sample) != 1 {
+			t.Fatalf("Sample() = %v, want one item", sample)
+		}
+		counts[sample[0]]++
+	}
+	for item, w := range weights {
+		checkBinomial(t, fmt.Sprintf("item of weight %v", w), counts[item], trials, math.Max(w, 0)/10)
+	}
+}
+
+// with k > 1, the first item drawn is proportional to weight and the second proportional to weight among the
+// remaining ones, so the inclusion probability of a lone heavy item is known exactly
+func TestWeightedReservoirWithoutReplacement(t *testing.T) {
+	r := NewSFRand()
+	const trials = 20000
+	heavy := 0
+	for i := 0; i < trials; i++ {
+		res := NewWeightedReservoir[int](r, 2)
+		res.Add(0, 8)
+		for item := 1; item <= 8; item++ {
+			res.Add(item, 1)
+		}
+		sample := res.Sample()
+		if len(sample) != 2 || sample[0] == sample[1] {
+			t.Fatalf("Sample() = %v, want two distinct items", sample)
+		}
+		if sample[0] == 0 || sample[1] == 0 {
+			heavy++
+		}
+	}
+	// drawn first with 8/16, or second after one of the light items with 8/16 * 8/15
+	checkBinomial(t, "heavy item sampled", heavy, trials, 8.0/16+8.0/16*8.0/15)
+}
+
+func TestWeightedReservoirShortStream(t *testing.T) {
+	res := NewWeightedReservoir[string](NewSFRand(), 5)
+	res.Add("a", 1)
+	res.Add("b", 0)
+	res.Add("c", 0.001)
+	if got := res.Sample(); len(got) != 2 {
+		t.Errorf("Sample() = %q, want a and c", got)
+	}
+}
+
+func TestNewWeightedReservoirPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewWeightedReservoir(0) did not panic")
+		}
+	}()
+	NewWeightedReservoir[int](NewSFRand(), 0)
+}
+
+func TestReservoirUniform(t *testing.T) {
+	r := NewSFRand()
+	for _, tc := range []struct {
+		n, k, trials int
+	}{
+		{10, 3, 20000},
+		{1000, 10, 2000},
+		{5, 1, 20000},
+	} {
+		counts := make([]int, tc.n)
+		for i := 0; i < tc.trials; i++ {
+			res := NewReservoir[int](r, tc.k)
+			for item := 0; item < tc.n; item++ {
+				res.Add(item)
+			}
+			sample := res.Sample()
+			if len(sample) != tc.k {
+				t.Fatalf("Sample() has %d items, want %d", len(sample), tc.k)
+			}
+			for _, item := range sample {
+				counts[item]++
+			}
+		}
+		// items are grouped into at most ten ranges so that late items, which Algorithm L skips over,
+		// are compared with early ones
+		groups := min(tc.n, 10)
+		for g := 0; g < groups; g++ {
+			sum := 0
+			for _, c := range counts[g*tc.n/groups : (g+1)*tc.n/groups] {
+				sum += c
+			}
+			what := fmt.Sprintf("n=%d k=%d items %d-%d sampled", tc.n, tc.k, g*tc.n/groups, (g+1)*tc.n/groups-1)
+			checkBinomial(t, what, sum, tc.trials*tc.n/groups, float64(tc.k)/float64(tc.n))
+		}
+	}
+}
+
+func TestReservoirShortStream(t *testing.T) {
+	res := NewReservoir[string](NewSFRand(), 5)
+	res.Add("a")
+	res.Add("b")
+	if got := res.Sample(); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("Sample() = %q, want [a b]", got)
+	}
+	got := res.Sample()
+	got[0] = "z"
+	if res.Sample()[0] != "a" {
+		t.Error("Sample() returned the reservoir's own slice")
+	}
+}
+
+func TestNewReservoirPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewReservoir(0) did not panic")
+		}
+	}()
+	NewReservoir[int](NewSFRand(), 0)
+}
@@ -0,0 +1,140 @@
+package random
+
+import (
+	"errors"
+	"hash/crc32"
+	"math"
+)
+
+// returns numeric code of exactly digits digits, each uniformly distributed, so leading zeros are kept as in
+// "004271". Use it for SMS verification codes and device PINs.
+func (r *randomizer) PIN(digits int) string {
+	return r.String(digits, GetNumericPool())
+}
+
+// digits of the base62 checksum of API keys, in ascending ASCII order
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// number of base62 characters of an API key's checksum, enough for any CRC-32
+const apiKeyChecksumLength = 6
+
+// returns API key of prefix, base62 characters carrying at least the entropy of entropyBytes random bytes and a
+// 6 character base62 CRC-32 checksum of everything before it, e.g. APIKey("sk_live_", 24). Like GitHub's tokens,
+// the checksum lets secret scanners tell real keys from look-alikes without a database lookup, see VerifyAPIKey.
+// It panics with an error wrapping ErrInsecureSource on randomizers backed by a seeded generator, see IsInsecure.
+func (r *randomizer) APIKey(prefix string, entropyBytes int) string {
+	r.mustBeSecure("APIKey")
+	key := prefix + r.StringFromPool(LengthForEntropy(len(base62Alphabet), float64(8*entropyBytes)), base62Alphabet)
+	return key + apiKeyChecksum(key)
+}
+
+// reports whether the checksum at the end of key, as generated by APIKey, matches the rest of it
+func VerifyAPIKey(key string) bool {
+	if len(key) < apiKeyChecksumLength {
+		return false
+	}
+	body := key[:len(key)-apiKeyChecksumLength]
+	return apiKeyChecksum(body) == key[len(body):]
+}
+
+func apiKeyChecksum(s string) string {
+	sum := crc32.ChecksumIEEE([]byte(s))
+	out := make([]byte, apiKeyChecksumLength)
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = base62Alphabet[sum%62]
+		sum /= 62
+	}
+	return string(out)
+}
+
+var ErrRecoveryCodes = errors.New("recovery codes of that length cannot be distinct in that number")
+
+// returns n distinct codes of length characters from GetUnambiguousLowercasePool, e.g. for 2FA backup codes.
+// It fails with ErrRecoveryCodes if there are fewer than n possible codes or n or length is negative, and with
+// ErrInsecureSource on randomizers backed by a seeded generator, see IsInsecure.
+func (r *randomizer) RecoveryCodes(n int, length int) ([]string, error) {
+	pool := GetUnambiguousLowercasePool()
+	if n < 0 || length < 0 || math.Pow(float64(len(pool)), float64(length)) < float64(n) {
+		return nil, ErrRecoveryCodes
+	}
+	if r.insecure {
+		return nil, ErrInsecureSource
+	}
+	codes := make([]string, 0, n)
+	seen := make(map[string]bool, n)
+	for len(codes) < n {
+		c := r.String(length, pool)
+		if !seen[c] {
+			seen[c] = true
+			codes = append(codes, c)
+		}
+	}
+	return codes, nil
+}
+
+var ErrTOTPSecretBits = errors.New("TOTP secret size must be a multiple of 8 bits and at least 128 bits")
+
+// returns TOTP shared secret of bits random bits as unpadded base32, the format authenticator apps expect in
+// otpauth:// URIs. RFC 4226 requires at least 128 bits and recommends 160. It fails with
+// ErrInsecureSource on randomizers backed by a seeded generator, see IsInsecure.
+func (r *randomizer) TOTPSecret(bits int) (string, error) {
+	if bits < 128 || bits%8 != 0 {
+		return "", ErrTOTPSecretBits
+	}
+	if r.insecure {
+		return "", ErrInsecureSource
+	}
+	return r.RawBase32(bits / 8), nil
+}
+
+const (
+	// nonce size of AES-GCM and ChaCha20-Poly1305
+	NonceSizeGCM = 12
+	// nonce size of XChaCha20-Poly1305
+	NonceSizeXChaCha = 24
+)
+
+// returns nonce of n random bytes. Prefer NonceGCM or NonceXChaCha for those AEADs. Random 12 byte nonces must not
+// be used for more than 2^32 messages under the same key. Like all Nonce methods, it panics with an error wrapping
+// ErrInsecureSource on randomizers backed by a seeded generator, see IsInsecure.
+func (r *randomizer) Nonce(n int) []byte {
+	r.mustBeSecure("Nonce")
+	return r.Bytes(n)
+}
+
+// returns random nonce for AES-GCM or ChaCha20-Poly1305
+func (r *randomizer) NonceGCM() []byte {
+	r.mustBeSecure("NonceGCM")
+	return r.Bytes(NonceSizeGCM)
+}
+
+// returns random nonce for XChaCha20-Poly1305, large enough to never repeat in practice
+func (r *randomizer) NonceXChaCha() []byte {
+	r.mustBeSecure("NonceXChaCha")
+	return r.Bytes(NonceSizeXChaCha)
+}
+
+// salt sizes in bytes for use with SaltN
+const (
+	// default of Salt, the 128 bits recommended for password hashing salts
+	SaltSize = 16
+	// salt size fixed by bcrypt
+	BcryptSaltSize = 16
+	// salt size recommended by OWASP for scrypt
+	ScryptSaltSize = 16
+	// salt size recommended by RFC 9106 for argon2id
+	Argon2SaltSize = 16
+)
+
+// returns salt of SaltSize random bytes. Like SaltN, it panics with an error wrapping ErrInsecureSource on
+// randomizers backed by a seeded generator, see IsInsecure.
+func (r *randomizer) Salt() []byte {
+	r.mustBeSecure("Salt")
+	return r.Bytes(SaltSize)
+}
+
+// returns salt of n random bytes, e.g. SaltN(Argon2SaltSize)
+func (r *randomizer) SaltN(n int) []byte {
+	r.mustBeSecure("SaltN")
+	return r.Bytes(n)
+}
@@ -0,0 +1,139 @@
+package random
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// returns the insecure randomizers that secret generators must refuse
+func insecureRandomizers() map[string]SFRand {
+	return map[string]SFRand{
+		"seeded":    NewSeededSFRand(1),
+		"fast":      NewFastInsecure(),
+		"with seed": NewSFRand(WithSeed(1)),
+	}
+}
+
+// calls f and fails t unless it panics with an error wrapping ErrInsecureSource
+func expectInsecurePanic(t *testing.T, name string, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrInsecureSource) {
+			t.Errorf("%s: panicked with %v, want ErrInsecureSource", name, err)
+		}
+	}()
+	f()
+}
+
+func TestAPIKey(t *testing.T) {
+	key := NewSFRand().APIKey("sk_live_", 24)
+	if !strings.HasPrefix(key, "sk_live_") || !VerifyAPIKey(key) {
+		t.Errorf("APIKey = %q, want sk_live_ prefix and valid checksum", key)
+	}
+	if len(key) != len("sk_live_")+33+apiKeyChecksumLength {
+		t.Errorf("APIKey = %q has %d characters", key, len(key))
+	}
+	for name, r := range insecureRandomizers() {
+		expectInsecurePanic(t, name, func() { r.APIKey("sk_", 24) })
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	for _, tc := range []struct {
+		n, length int
+		err       error
+	}{
+		{10, 8, nil},
+		{0, 8, nil},
+		{-1, 8, ErrRecoveryCodes},
+		{1, -1, ErrRecoveryCodes},
+		{len(GetUnambiguousLowercasePool()) + 1, 1, ErrRecoveryCodes},
+		{len(GetUnambiguousLowercasePool()), 1, nil},
+	} {
+		codes, err := NewSFRand().RecoveryCodes(tc.n, tc.length)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("RecoveryCodes(%d, %d) error = %v, want %v", tc.n, tc.length, err, tc.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		seen := map[string]bool{}
+		for _, c := range codes {
+			if len(c) != tc.length || seen[c] {
+				t.Errorf("RecoveryCodes(%d, %d) returned %q, want distinct codes of that length", tc.n, tc.length, codes)
+				break
+			}
+			seen[c] = true
+		}
+		if len(codes) != tc.n {
+			t.Errorf("RecoveryCodes(%d, %d) returned %d codes", tc.n, tc.length, len(codes))
+		}
+	}
+	for name, r := range insecureRandomizers() {
+		if codes, err := r.RecoveryCodes(10, 8); !errors.Is(err, ErrInsecureSource) {
+			t.Errorf("%s: RecoveryCodes = %q, %v, want ErrInsecureSource", name, codes, err)
+		}
+	}
+}
+
+func TestTOTPSecret(t *testing.T) {
+	for _, tc := range []struct {
+		bits   int
+		length int
+		err    error
+	}{
+		{128, 26, nil},
+		{160, 32, nil},
+		{256, 52, nil},
+		{0, 0, ErrTOTPSecretBits},
+		{80, 0, ErrTOTPSecretBits},
+		{120, 0, ErrTOTPSecretBits},
+		{161, 0, ErrTOTPSecretBits},
+	} {
+		s, err := NewSFRand().TOTPSecret(tc.bits)
+		if !errors.Is(err, tc.err) || len(s) != tc.length {
+			t.Errorf("TOTPSecret(%d) = %q, %v, want %d characters and %v", tc.bits, s, err, tc.length, tc.err)
+		}
+	}
+	for name, r := range insecureRandomizers() {
+		if s, err := r.TOTPSecret(160); !errors.Is(err, ErrInsecureSource) {
+			t.Errorf("%s: TOTPSecret = %q, %v, want ErrInsecureSource", name, s, err)
+		}
+	}
+}
+
+func TestNonce(t *testing.T) {
+	r := NewSFRand()
+	if n := len(r.Nonce(7)); n != 7 {
+		t.Errorf("Nonce(7) has %d bytes", n)
+	}
+	if n := len(r.NonceGCM()); n != NonceSizeGCM {
+		t.Errorf("NonceGCM has %d bytes, want %d", n, NonceSizeGCM)
+	}
+	if n := len(r.NonceXChaCha()); n != NonceSizeXChaCha {
+		t.Errorf("NonceXChaCha has %d bytes, want %d", n, NonceSizeXChaCha)
+	}
+	for name, r := range insecureRandomizers() {
+		expectInsecurePanic(t, name+" Nonce", func() { r.Nonce(12) })
+		expectInsecurePanic(t, name+" NonceGCM", func() { r.NonceGCM() })
+		expectInsecurePanic(t, name+" NonceXChaCha", func() { r.NonceXChaCha() })
+	}
+}
+
+func TestSalt(t *testing.T) {
+	r := NewSFRand()
+	if n := len(r.Salt()); n != SaltSize {
+		t.Errorf("Salt has %d bytes, want %d", n, SaltSize)
+	}
+	if n := len(r.SaltN(Argon2SaltSize)); n != Argon2SaltSize {
+		t.Errorf("SaltN(Argon2SaltSize) has %d bytes, want %d", n, Argon2SaltSize)
+	}
+	for name, r := range insecureRandomizers() {
+		expectInsecurePanic(t, name+" Salt", func() { r.Salt() })
+		expectInsecurePanic(t, name+" SaltN", func() { r.SaltN(32) })
+	}
+}
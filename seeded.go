@@ -0,0 +1,71 @@
+package random
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sync"
+)
+
+// returns SFRand whose output is fully determined by seed: the same seed yields the same sequence of values
+// on every run and platform, e.g. for reproducible fixtures in table-driven tests. It is backed by xoshiro256**
+// and must never be used for secrets. Values derived from the clock, such as ULID timestamps, still vary.
+func NewSeededSFRand(seed int64) SFRand {
+	return NewSFRand(WithSeed(seed))
+}
+
+// xoshiro256** generator, see https://prng.di.unimi.it. It is safe for concurrent use.
+type xoshiro struct {
+	mtx sync.Mutex
+	s   [4]uint64
+}
+
+// returns xoshiro seeded with seed expanded by splitmix64, which never yields the all-zero state
+func newXoshiro(seed uint64) *xoshiro {
+	x := &xoshiro{}
+	for i := range x.s {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		x.s[i] = z ^ (z >> 31)
+	}
+	return x
+}
+
+func (x *xoshiro) Uint64() uint64 {
+	x.mtx.Lock()
+	v := x.next()
+	x.mtx.Unlock()
+	return v
+}
+
+// fills p with pseudo-random bytes, it never fails
+func (x *xoshiro) Read(p []byte) (int, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+	n := len(p)
+	for len(p) >= 8 {
+		binary.LittleEndian.PutUint64(p, x.next())
+		p = p[8:]
+	}
+	if len(p) > 0 {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], x.next())
+		copy(p, b[:])
+	}
+	return n, nil
+}
+
+// advances the state, the caller must hold mtx
+func (x *xoshiro) next() uint64 {
+	s := &x.s
+	v := bits.RotateLeft64(s[1]*5, 7) * 9
+	t := s[1] << 17
+	s[2] ^= s[0]
+	s[3] ^= s[1]
+	s[1] ^= s[2]
+	s[0] ^= s[3]
+	s[2] ^= t
+	s[3] = bits.RotateLeft64(s[3], 45)
+	return v
+}
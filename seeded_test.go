@@ -0,0 +1,28 @@
+package random
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"testing/iotest"
+)
+
+func TestWithSeed(t *testing.T) {
+	a := NewSeededSFRand(42)
+	b := NewSFRand(WithSeed(42), WithEntropySource(iotest.ErrReader(errors.New("unused"))), WithFallbackDisabled())
+	for i := 0; i < 100; i++ {
+		x, y := a.Int(0, 1<<30), b.Int(0, 1<<30)
+		if x != y {
+			t.Fatalf("value %d: NewSeededSFRand = %d, WithSeed = %d", i, x, y)
+		}
+	}
+	if x, y := a.Bytes(32), b.Bytes(32); !bytes.Equal(x, y) {
+		t.Errorf("Bytes: NewSeededSFRand = %x, WithSeed = %x", x, y)
+	}
+	if x, y := NewSFRand(WithSeed(1)).Int(0, 1<<30), NewSFRand(WithSeed(2)).Int(0, 1<<30); x == y {
+		t.Errorf("seeds 1 and 2 both yielded %d", x)
+	}
+	if _, err := b.Mnemonic(12); !errors.Is(err, ErrInsecureSource) {
+		t.Errorf("Mnemonic with WithSeed = %v, want ErrInsecureSource", err)
+	}
+}
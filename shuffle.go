@@ -0,0 +1,64 @@
+package random
+
+import "errors"
+
+// pseudo-randomizes the order of items in place with a Fisher–Yates shuffle
+func Shuffle[T any](r SFRand, items []T) {
+	r.ShuffleFunc(len(items), func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+}
+
+// pseudo-randomizes the order of n elements with a Fisher–Yates shuffle, swap swaps the elements with
+// indexes i and j. Every permutation is equally likely. It panics if n < 0.
+func (r *randomizer) ShuffleFunc(n int, swap func(i, j int)) {
+	if n < 0 {
+		panic("invalid argument to ShuffleFunc: n < 0")
+	}
+	for i := n - 1; i > 0; i-- {
+		swap(i, r.Int(0, i))
+	}
+}
+
+var ErrSampleSize = errors.New("sample size must be between 0 and the number of items")
+
+// returns k elements of items at distinct positions, chosen uniformly and in pseudo-random order. It runs a
+// partial Fisher–Yates shuffle over the positions that only tracks moved ones, so time and memory are O(k)
+// regardless of len(items), and items is left unchanged.
+func Sample[T any](r SFRand, items []T, k int) ([]T, error) {
+	if k < 0 || k > len(items) {
+		return nil, ErrSampleSize
+	}
+	// positions swapped away from their place, by the position they were moved to
+	moved := make(map[int]int, k)
+	out := make([]T, k)
+	for i := range out {
+		j := r.Int(i, len(items)-1)
+		pj, ok := moved[j]
+		if !ok {
+			pj = j
+		}
+		pi, ok := moved[i]
+		if !ok {
+			pi = i
+		}
+		moved[j] = pi
+		out[i] = items[pj]
+	}
+	return out, nil
+}
+
+// returns pseudo-random permutation of the integers [0,n). It panics if n < 0.
+func (r *randomizer) Perm(n int) []int {
+	if n < 0 {
+		panic("invalid argument to Perm: n < 0")
+	}
+	// inside-out Fisher–Yates: element i is placed at a random position among the first i+1
+	p := make([]int, n)
+	for i := range p {
+		j := r.Int(0, i)
+		p[i] = p[j]
+		p[j] = i
+	}
+	return p
+}
@@ -0,0 +1,133 @@
+package random
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+)
+
+// variables rather than constants so that tests can exercise the external shuffle with small inputs
+var (
+	// bytes of lines ShuffleLines keeps in memory before spilling to temporary files
+	shuffleMemoryLimit = 64 << 20
+	// temporary files lines are scattered into per pass
+	shuffleBuckets = 64
+)
+
+const (
+	// passes after which a bucket is shuffled in memory regardless of its size, e.g. for a single huge line
+	shuffleMaxDepth = 4
+)
+
+// writes the lines of src to w in pseudo-random order. Input too large for memory is shuffled externally:
+// lines are scattered into pseudo-random temporary files in tmpDir (os.TempDir() if empty), each of which is
+// then shuffled the same way and appended to w, which yields a uniformly random permutation. Temporary files
+// are removed before returning. Every written line ends with '\n', including a last line that did not.
+func (r *randomizer) ShuffleLines(src io.Reader, w io.Writer, tmpDir string) error {
+	bw := bufio.NewWriter(w)
+	if err := r.shuffleLines(bufio.NewReader(src), bw, tmpDir, 0); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func (r *randomizer) shuffleLines(br *bufio.Reader, w *bufio.Writer, tmpDir string, depth int) error {
+	var lines [][]byte
+	size := 0
+	for {
+		line, err := readLine(br)
+		if line != nil {
+			lines = append(lines, line)
+			size += len(line)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if size > shuffleMemoryLimit && depth < shuffleMaxDepth {
+			return r.scatterLines(lines, br, w, tmpDir, depth)
+		}
+	}
+
+	Shuffle(r, lines)
+	for _, line := range lines {
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writes buffered and the remaining lines of br into pseudo-random buckets, then shuffles every bucket into w
+func (r *randomizer) scatterLines(buffered [][]byte, br *bufio.Reader, w *bufio.Writer, tmpDir string, depth int) (err error) {
+	files := make([]*os.File, 0, shuffleBuckets)
+	defer func() {
+		for _, f := range files {
+			if cerr := f.Close(); cerr != nil && err == nil {
+				err = cerr
+			}
+			if rerr := os.Remove(f.Name()); rerr != nil && err == nil {
+				err = rerr
+			}
+		}
+	}()
+	buckets := make([]*bufio.Writer, shuffleBuckets)
+	for i := range buckets {
+		f, err := os.CreateTemp(tmpDir, "shuffle-*")
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+		buckets[i] = bufio.NewWriter(f)
+	}
+
+	for i, line := range buffered {
+		if _, err := buckets[r.Int(0, shuffleBuckets-1)].Write(line); err != nil {
+			return err
+		}
+		buffered[i] = nil
+	}
+	for {
+		line, err := readLine(br)
+		if line != nil {
+			if _, werr := buckets[r.Int(0, shuffleBuckets-1)].Write(line); werr != nil {
+				return werr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, f := range files {
+		if err := buckets[i].Flush(); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if err := r.shuffleLines(bufio.NewReader(f), w, tmpDir, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// returns next line of br including its '\n', which is added if the input ends without one.
+// The line is nil once br is exhausted.
+func readLine(br *bufio.Reader) ([]byte, error) {
+	line, err := br.ReadBytes('\n')
+	if len(line) == 0 {
+		return nil, err
+	}
+	if line[len(line)-1] != '\n' {
+		line = append(line, '\n')
+	}
+	return line, err
+}
@@ -0,0 +1,109 @@
+package random
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// shuffles input with ShuffleLines and fails t unless the output is a permutation of want
+func shuffleLinesChecked(t *testing.T, r SFRand, input string, want []string, tmpDir string) []string {
+	t.Helper()
+	var out bytes.Buffer
+	if err := r.ShuffleLines(strings.NewReader(input), &out, tmpDir); err != nil {
+		t.Fatal(err)
+	}
+	got := strings.SplitAfter(out.String(), "\n")
+	if got[len(got)-1] == "" {
+		got = got[:len(got)-1]
+	}
+	sorted := append([]string(nil), got...)
+	sort.Strings(sorted)
+	sortedWant := append([]string(nil), want...)
+	sort.Strings(sortedWant)
+	if strings.Join(sorted, "") != strings.Join(sortedWant, "") {
+		t.Fatalf("ShuffleLines(%q) = %q, not a permutation of %q", input, got, want)
+	}
+	return got
+}
+
+// lowers the memory limit and number of buckets of ShuffleLines for the duration of the test
+func withShuffleLimits(t *testing.T, limit int, buckets int) {
+	oldLimit, oldBuckets := shuffleMemoryLimit, shuffleBuckets
+	shuffleMemoryLimit, shuffleBuckets = limit, buckets
+	t.Cleanup(func() { shuffleMemoryLimit, shuffleBuckets = oldLimit, oldBuckets })
+}
+
+func TestShuffleLines(t *testing.T) {
+	r := NewSFRand()
+	for _, tc := range []struct {
+		input string
+		want  []string
+	}{
+		{"", nil},
+		{"a", []string{"a\n"}},
+		{"a\n", []string{"a\n"}},
+		{"a\nb\nc", []string{"a\n", "b\n", "c\n"}},
+		{"a\n\nb\n\n", []string{"a\n", "\n", "b\n", "\n"}},
+	} {
+		shuffleLinesChecked(t, r, tc.input, tc.want, "")
+	}
+}
+
+func TestShuffleLinesExternal(t *testing.T) {
+	withShuffleLimits(t, 100, 4)
+	var input strings.Builder
+	want := make([]string, 2000)
+	for i := range want {
+		want[i] = fmt.Sprintf("line %d\n", i)
+		input.WriteString(want[i])
+	}
+	dir := t.TempDir()
+	var out bytes.Buffer
+	if err := NewSFRand().ShuffleLines(strings.NewReader(input.String()), &out, dir); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("ShuffleLines left %d temporary files behind", len(entries))
+	}
+	got := shuffleLinesChecked(t, NewSFRand(), input.String(), want, dir)
+	if strings.Join(got, "") == input.String() {
+		t.Error("ShuffleLines kept the order of 2000 lines")
+	}
+}
+
+// every permutation of three lines must be about equally likely, in memory and when spilled to disk
+func TestShuffleLinesUniform(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		limit  int
+		trials int
+	}{
+		{"memory", 64 << 20, 6000},
+		{"external", 10, 400},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			withShuffleLimits(t, tc.limit, 4)
+			r, dir := NewSFRand(), t.TempDir()
+			lines := []string{"line0\n", "line1\n", "line2\n"}
+			counts := map[string]int{}
+			for i := 0; i < tc.trials; i++ {
+				counts[strings.Join(shuffleLinesChecked(t, r, strings.Join(lines, ""), lines, dir), "")]++
+			}
+			if len(counts) != 6 {
+				t.Fatalf("%d of 6 permutations seen: %v", len(counts), counts)
+			}
+			for p, n := range counts {
+				// five standard deviations of a binomial count with p = 1/6
+				want := float64(tc.trials) / 6
+				if math.Abs(float64(n)-want) > 5*math.Sqrt(want*5/6) {
+					t.Errorf("permutation %q seen %d times out of %d, want about %.0f", p, n, tc.trials, want)
+				}
+			}
+		})
+	}
+}
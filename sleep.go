@@ -0,0 +1,25 @@
+package random
+
+import (
+	"context"
+	"time"
+)
+
+// sleeps for a pseudo-random duration in [min,max] and returns nil, or returns ctx.Err() as soon as ctx is done.
+// It panics if max < min.
+func (r *randomizer) SleepJitter(ctx context.Context, min time.Duration, max time.Duration) error {
+	if max < min {
+		panic("invalid argument to SleepJitter: max < min")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	t := time.NewTimer(r.Duration(min, max))
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,24 @@
+package random
+
+import mathrand "math/rand"
+
+// returns math/rand Source64 drawing from this SFRand, so that libraries only accepting a *rand.Rand can be
+// driven by it, e.g. rand.New(r.AsSource()). It also satisfies math/rand/v2's Source for rand.New of that
+// package. Seed is a no-op. The source is safe for concurrent use, a *rand.Rand built on it is not.
+func (r *randomizer) AsSource() mathrand.Source64 {
+	return source{r}
+}
+
+type source struct {
+	r *randomizer
+}
+
+func (s source) Int63() int64 {
+	return int64(s.r.uint64() >> 1)
+}
+
+func (s source) Uint64() uint64 {
+	return s.r.uint64()
+}
+
+func (s source) Seed(int64) {}
@@ -0,0 +1,41 @@
+package random
+
+import "context"
+
+// produces values of a generator on a channel for consumers in any number of goroutines, e.g. workers of a
+// load generator. A single goroutine calls the generator ahead of the consumers, at most as far as the channel's
+// buffer allows, and stops once the context is done.
+type Stream[T any] struct {
+	c chan T
+}
+
+// returns Stream of values of gen, buffering up to buffer values, that runs until ctx is done, e.g.
+//
+//	ids := NewStream(ctx, 64, rnd.UUID)
+//	for id := range ids.C() { ... }
+func NewStream[T any](ctx context.Context, buffer int, gen func() T) *Stream[T] {
+	s := &Stream[T]{c: make(chan T, buffer)}
+	go func() {
+		defer close(s.c)
+		for {
+			v := gen()
+			select {
+			case s.c <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return s
+}
+
+// returns the channel delivering the values, it is closed once the stream's context is done
+func (s *Stream[T]) C() <-chan T {
+	return s.c
+}
+
+// returns the next value, or false once the stream's context is done and no values are left
+func (s *Stream[T]) Next() (T, bool) {
+	v, ok := <-s.c
+	return v, ok
+}
@@ -0,0 +1,104 @@
+package random
+
+import (
+	cryptorand "crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// returned by the E variants of methods and NewSFRandStrict when the entropy source fails, wrapping its error
+var ErrEntropyUnavailable = errors.New("entropy source unavailable")
+
+// returned by methods generating secrets, such as Mnemonic, on randomizers backed by a seeded pseudo-random
+// generator like NewSeededSFRand and NewFastInsecure
+var ErrInsecureSource = errors.New("randomizer is not backed by a cryptographically secure source")
+
+// reports whether r is backed by a seeded pseudo-random generator whose values can be predicted, such as the
+// randomizers of NewSeededSFRand, NewFastInsecure and WithSeed. Other implementations of SFRand are reported as
+// insecure if they have an Insecure() bool method returning true.
+func IsInsecure(r SFRand) bool {
+	switch r := r.(type) {
+	case *randomizer:
+		return r.insecure
+	case interface{ Insecure() bool }:
+		return r.Insecure()
+	}
+	return false
+}
+
+// panics with an error wrapping ErrInsecureSource if r is insecure, for methods generating secrets that
+// cannot return an error
+func (r *randomizer) mustBeSecure(op string) {
+	if r.insecure {
+		panic(fmt.Errorf("%s: %w", op, ErrInsecureSource))
+	}
+}
+
+func entropyError(err error) error {
+	return fmt.Errorf("%w: %w", ErrEntropyUnavailable, err)
+}
+
+// same as Int but returns an error wrapping ErrEntropyUnavailable instead of falling back to math/rand
+// when the entropy source fails
+func (r *randomizer) IntE(min int, max int) (int, error) {
+	res, err := readInt(r.src, min, max)
+	if err != nil {
+		return 0, entropyError(err)
+	}
+	return res, nil
+}
+
+// same as Bytes but returns an error wrapping ErrEntropyUnavailable instead of falling back to math/rand
+// when the entropy source fails
+func (r *randomizer) BytesE(n int) ([]byte, error) {
+	res, err := readBytes(r.src, n)
+	if err != nil {
+		return nil, entropyError(err)
+	}
+	return res, nil
+}
+
+// same as Rune but returns an error wrapping ErrEntropyUnavailable instead of falling back to math/rand
+// when the entropy source fails
+func (r *randomizer) RuneE(pool []rune) (rune, error) {
+	i, err := r.IntE(0, len(pool)-1)
+	if err != nil {
+		return 0, err
+	}
+	return pool[i], nil
+}
+
+// same as String but returns an error wrapping ErrEntropyUnavailable instead of falling back to math/rand
+// when the entropy source fails
+func (r *randomizer) StringE(length int, pool []rune, opts ...StringOption) (s string, err error) {
+	err = r.strictly(func(strict *randomizer) {
+		s = strict.String(length, pool, opts...)
+	})
+	return s, err
+}
+
+// calls f with a randomizer sharing r's source that never falls back, and returns the entropy error
+// that made it stop early, if any
+func (r *randomizer) strictly(f func(strict *randomizer)) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			if e, ok := v.(error); ok && errors.Is(e, ErrEntropyUnavailable) {
+				err = e
+				return
+			}
+			panic(v)
+		}
+	}()
+	f(&randomizer{rnd: r.rnd, src: r.src, strict: true, insecure: r.insecure, logger: r.logger})
+	return nil
+}
+
+// returns SFRand that reads from crypto/rand only and never falls back to math/rand. Construction fails with
+// ErrEntropyUnavailable if crypto/rand cannot be read; if it fails later on, the E variants of methods return
+// the error and all other methods panic with it.
+func NewSFRandStrict() (SFRand, error) {
+	if _, err := readBytes(cryptorand.Reader, 1); err != nil {
+		return nil, entropyError(err)
+	}
+	return NewSFRand(WithFallbackDisabled()), nil
+}
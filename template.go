@@ -0,0 +1,48 @@
+package random
+
+import (
+	"errors"
+	"reflect"
+	"text/template"
+)
+
+// returns functions exposing r to text/template and html/template, usable e.g. as
+//
+//	{{ randInt 1 6 }} {{ randString 12 }} {{ randString 4 "0123456789" }} {{ uuid }} {{ pick "a" "b" "c" }}
+//
+// for html/template convert the result with html/template.FuncMap(TemplateFuncs(r))
+func TemplateFuncs(r SFRand) template.FuncMap {
+	return template.FuncMap{
+		"randInt":  r.Int,
+		"randBool": r.Bool,
+		"randString": func(length int, pool ...string) (string, error) {
+			switch len(pool) {
+			case 0:
+				return r.String(length, GetAlphaNumericPool()), nil
+			case 1:
+				if pool[0] == "" {
+					return "", errors.New("randString: empty pool")
+				}
+				return r.String(length, []rune(pool[0])), nil
+			default:
+				return "", errors.New("randString: expected at most one pool")
+			}
+		},
+		"uuid": r.UUID,
+		"pick": func(items ...any) (any, error) {
+			// a single slice argument picks one of its elements
+			if len(items) == 1 {
+				if v := reflect.ValueOf(items[0]); v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+					if v.Len() == 0 {
+						return nil, errors.New("pick: empty list")
+					}
+					return v.Index(r.Int(0, v.Len()-1)).Interface(), nil
+				}
+			}
+			if len(items) == 0 {
+				return nil, errors.New("pick: no items")
+			}
+			return items[r.Int(0, len(items)-1)], nil
+		},
+	}
+}
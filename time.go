@@ -0,0 +1,54 @@
+package random
+
+import (
+	"math"
+	"time"
+)
+
+// returns uniformly distributed pseudo-random duration between min and max, inclusive, e.g. for retry delays
+// or scattering cache TTLs. It panics if max < min.
+func (r *randomizer) Duration(min time.Duration, max time.Duration) time.Duration {
+	if max < min {
+		panic("invalid argument to Duration: max < min")
+	}
+	return time.Duration(r.Int64(int64(min), int64(max)))
+}
+
+// returns uniformly distributed pseudo-random instant between start and end, inclusive, in the location of
+// start, e.g. for timestamps in fixtures or backfilled data. It panics if end is before start.
+func (r *randomizer) Time(start time.Time, end time.Time) time.Time {
+	if end.Before(start) {
+		panic("invalid argument to Time: end before start")
+	}
+	// Sub saturates at the maximum Duration, about 292 years
+	if d := end.Sub(start); d < math.MaxInt64 {
+		return start.Add(r.Duration(0, d))
+	}
+	// longer spans are drawn as a second and a nanosecond within it, redrawing instants outside of them
+	for {
+		t := time.Unix(r.Int64(start.Unix(), end.Unix()), int64(r.Int(0, 1e9-1))).In(start.Location())
+		if !t.Before(start) && !t.After(end) {
+			return t
+		}
+	}
+}
+
+// returns pseudo-random day of the week
+func (r *randomizer) Weekday() time.Weekday {
+	return time.Weekday(r.Int(int(time.Sunday), int(time.Saturday)))
+}
+
+// returns pseudo-random month
+func (r *randomizer) Month() time.Month {
+	return time.Month(r.Int(int(time.January), int(time.December)))
+}
+
+// returns pseudo-random day of month in year, from 1 up to the length of that month including leap days of
+// the Gregorian calendar. It panics if month is not in [January,December].
+func (r *randomizer) DayOfMonth(year int, month time.Month) int {
+	if month < time.January || month > time.December {
+		panic("invalid argument to DayOfMonth: unknown month")
+	}
+	// day 0 of the next month normalizes to the last day of month
+	return r.Int(1, time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day())
+}
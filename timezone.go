@@ -0,0 +1,38 @@
+package random
+
+import (
+	_ "embed"
+	"strings"
+	"time"
+)
+
+//go:embed data/timezones.txt
+var timezoneData string
+
+var timezones = func() []string {
+	var names []string
+	for _, line := range strings.Split(timezoneData, "\n") {
+		if line != "" && !strings.HasPrefix(line, "#") {
+			names = append(names, line)
+		}
+	}
+	return names
+}()
+
+// returns IANA time zone name drawn with r, e.g. "America/Argentina/Ushuaia"
+func Timezone(r SFRand) string {
+	return timezones[r.Int(0, len(timezones)-1)]
+}
+
+// returns location of a pseudo-random IANA time zone. Zones are loaded with time.LoadLocation, so
+// programs running where no tz database is installed should import time/tzdata.
+// If the zone cannot be loaded it logs the reason and returns time.UTC.
+func (r *randomizer) Location() *time.Location {
+	name := Timezone(r)
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		r.logf("failed to load time zone %s for Location(). Reason: %s", name, err.Error())
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,29 @@
+package random
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestTimezones(t *testing.T) {
+	seen := map[string]bool{}
+	for _, name := range timezones {
+		if seen[name] {
+			t.Errorf("time zone %s is listed twice", name)
+		}
+		seen[name] = true
+		if _, err := time.LoadLocation(name); err != nil {
+			t.Errorf("time zone %s does not load: %v", name, err)
+		}
+	}
+	r := NewSFRand()
+	for i := 0; i < 100; i++ {
+		if name := Timezone(r); !seen[name] {
+			t.Fatalf("Timezone() = %q, not in the embedded list", name)
+		}
+		if loc := r.Location(); !seen[loc.String()] {
+			t.Fatalf("Location() = %v, not in the embedded list", loc)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package random
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// Crockford's base32 alphabet used by ULIDs
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// returns ULID of the current millisecond timestamp and 80 random bits. ULIDs of the same millisecond are not
+// ordered among each other, see MonotonicULID for that.
+func (r *randomizer) ULID() string {
+	return encodeULID(uint64(time.Now().UnixMilli()), r.Bytes(10))
+}
+
+// generates ULIDs that strictly increase, also within the same millisecond. Within a millisecond the random part
+// of the previous ULID is increased by a random amount instead of 1, so consecutive ULIDs stay hard to guess.
+// It is safe for concurrent use.
+type MonotonicULID struct {
+	r SFRand
+	m monotonic
+}
+
+// returns MonotonicULID drawing from r
+func NewMonotonicULID(r SFRand) *MonotonicULID {
+	return &MonotonicULID{r: r, m: monotonic{hiBits: 16}}
+}
+
+// returns the next ULID, greater than all previously returned ones
+func (g *MonotonicULID) Next() string {
+	ms, hi, lo := g.m.next(g.r)
+	entropy := make([]byte, 10)
+	binary.BigEndian.PutUint16(entropy, uint16(hi))
+	binary.BigEndian.PutUint64(entropy[2:], lo)
+	return encodeULID(ms, entropy)
+}
+
+// returns n ULIDs sharing the current millisecond timestamp, drawing the entropy for all of them in a single read.
+// Within that millisecond they are not ordered, see MonotonicULID.
+func (r *randomizer) ULIDs(n int) []string {
+	ms := uint64(time.Now().UnixMilli())
+	b := r.Bytes(10 * n)
+	out := make([]string, n)
+	for i := range out {
+		out[i] = encodeULID(ms, b[10*i:10*i+10])
+	}
+	return out
+}
+
+// returns 26 character ULID of a 48 bit millisecond timestamp and 80 bits of entropy
+func encodeULID(ms uint64, entropy []byte) string {
+	var id [16]byte
+	putUint48(id[:], ms)
+	copy(id[6:], entropy)
+
+	// 128 bits are encoded as 26 characters of 5 bits, the first character only carries 3 bits
+	out := make([]byte, 26)
+	var acc uint32
+	bits := 2 // 2 padding bits in front of the first byte
+	pos := 0
+	for _, c := range id {
+		acc = acc<<8 | uint32(c)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out[pos] = crockfordAlphabet[acc>>bits&31]
+			pos++
+		}
+	}
+	return string(out)
+}
@@ -0,0 +1,119 @@
+package random
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// returns millisecond timestamp of a ULID and fails t unless it is 26 characters of Crockford's base32
+func checkULID(t *testing.T, id string) uint64 {
+	t.Helper()
+	if len(id) != 26 || id[0] > '7' {
+		t.Fatalf("%q is not a ULID", id)
+	}
+	var ms uint64
+	for i, c := range id {
+		v := strings.IndexRune(crockfordAlphabet, c)
+		if v < 0 {
+			t.Fatalf("%q has character %q outside Crockford's base32", id, c)
+		}
+		if i < 10 {
+			ms = ms<<5 | uint64(v)
+		}
+	}
+	return ms
+}
+
+func TestULID(t *testing.T) {
+	r := NewSFRand()
+	before := uint64(time.Now().UnixMilli())
+	ids := append(r.ULIDs(3), r.ULID())
+	after := uint64(time.Now().UnixMilli())
+	for _, id := range ids {
+		if ms := checkULID(t, id); ms < before || ms > after {
+			t.Errorf("ULID %q has timestamp %d, want within [%d, %d]", id, ms, before, after)
+		}
+	}
+}
+
+func TestEncodeULID(t *testing.T) {
+	for _, tc := range []struct {
+		ms      uint64
+		entropy []byte
+		want    string
+	}{
+		{0, make([]byte, 10), "00000000000000000000000000"},
+		{1<<48 - 1, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		{1469918176385, make([]byte, 10), "01ARYZ6S410000000000000000"},
+		{0, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1}, "00000000000000000000000001"},
+	} {
+		if got := encodeULID(tc.ms, tc.entropy); got != tc.want {
+			t.Errorf("encodeULID(%d, %x) = %q, want %q", tc.ms, tc.entropy, got, tc.want)
+		}
+	}
+}
+
+func TestMonotonicULIDOrdered(t *testing.T) {
+	g := NewMonotonicULID(NewSFRand())
+	prev := g.Next()
+	for i := 0; i < 100000; i++ {
+		id := g.Next()
+		if id <= prev {
+			t.Fatalf("ULID %d %q is not greater than the previous %q", i, id, prev)
+		}
+		prev = id
+	}
+	checkULID(t, prev)
+}
+
+func TestMonotonicULIDConcurrent(t *testing.T) {
+	g := NewMonotonicULID(NewSFRand())
+	const goroutines, n = 8, 2000
+	ids := make([][]string, goroutines)
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < n; j++ {
+				ids[i] = append(ids[i], g.Next())
+			}
+		}(i)
+	}
+	wg.Wait()
+	var all []string
+	for _, own := range ids {
+		if !sort.StringsAreSorted(own) {
+			t.Error("ULIDs of a single goroutine are not increasing")
+		}
+		all = append(all, own...)
+	}
+	sort.Strings(all)
+	for i := 1; i < len(all); i++ {
+		if all[i] == all[i-1] {
+			t.Fatalf("ULID %q generated twice", all[i])
+		}
+	}
+}
+
+func TestMonotonicULIDClockBack(t *testing.T) {
+	g := NewMonotonicULID(NewSFRand())
+	future := uint64(time.Now().UnixMilli()) + 60000
+	g.m.ms = future
+	g.m.hi = 1<<16 - 1
+	g.m.lo = 1<<64 - 1<<20
+	prev := g.Next()
+	for i := 0; i < 1000; i++ {
+		id := g.Next()
+		if id <= prev {
+			t.Fatalf("ULID %q is not greater than the previous %q", id, prev)
+		}
+		prev = id
+	}
+	if ms := checkULID(t, prev); ms < future {
+		t.Errorf("ULID %q went back to timestamp %d from %d", prev, ms, future)
+	}
+}
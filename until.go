@@ -0,0 +1,38 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidAttempts = errors.New("maxAttempts must be greater than 0")
+
+// returned when no generated value satisfied the predicate within the allowed attempts
+type ExhaustedError struct {
+	Attempts int
+}
+
+func (e *ExhaustedError) Error() string {
+	return fmt.Sprintf("no value satisfied the predicate in %d attempts", e.Attempts)
+}
+
+// calls gen until ok accepts its result and returns that result. It gives up with an *ExhaustedError
+// after maxAttempts calls, so an unsatisfiable predicate can never loop forever.
+func Until[T any](gen func() T, ok func(T) bool, maxAttempts int) (T, error) {
+	var zero T
+	if maxAttempts <= 0 {
+		return zero, ErrInvalidAttempts
+	}
+	for i := 0; i < maxAttempts; i++ {
+		if v := gen(); ok(v) {
+			return v, nil
+		}
+	}
+	return zero, &ExhaustedError{Attempts: maxAttempts}
+}
+
+// returns string of pseudo-random runes from pool accepted by ok, regenerating up to maxAttempts times.
+// See Until for the returned errors.
+func (r *randomizer) StringMatching(length int, pool []rune, ok func(string) bool, maxAttempts int) (string, error) {
+	return Until(func() string { return r.String(length, pool) }, ok, maxAttempts)
+}
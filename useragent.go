@@ -0,0 +1,176 @@
+package random
+
+import "fmt"
+
+// browser family of a User-Agent, see UserAgent
+type Browser int
+
+const (
+	BrowserChrome Browser = iota
+	BrowserSafari
+	BrowserEdge
+	BrowserFirefox
+)
+
+func (b Browser) String() string {
+	switch b {
+	case BrowserChrome:
+		return "Chrome"
+	case BrowserSafari:
+		return "Safari"
+	case BrowserEdge:
+		return "Edge"
+	case BrowserFirefox:
+		return "Firefox"
+	}
+	return fmt.Sprintf("Browser(%d)", int(b))
+}
+
+// operating system of a User-Agent, see UserAgent
+type OperatingSystem int
+
+const (
+	OSWindows OperatingSystem = iota
+	OSMacOS
+	OSLinux
+	OSAndroid
+	OSIOS
+)
+
+func (o OperatingSystem) String() string {
+	switch o {
+	case OSWindows:
+		return "Windows"
+	case OSMacOS:
+		return "macOS"
+	case OSLinux:
+		return "Linux"
+	case OSAndroid:
+		return "Android"
+	case OSIOS:
+		return "iOS"
+	}
+	return fmt.Sprintf("OperatingSystem(%d)", int(o))
+}
+
+// configures UserAgent
+type UserAgentOption func(*userAgentOptions)
+
+type userAgentOptions struct {
+	browsers map[Browser]int
+	systems  map[OperatingSystem]int
+}
+
+// makes UserAgent pick browsers with probability proportional to weights instead of their approximate market
+// share. Browsers missing from weights or with a weight <= 0 are never picked.
+func WithBrowserWeights(weights map[Browser]int) UserAgentOption {
+	return func(o *userAgentOptions) {
+		o.browsers = weights
+	}
+}
+
+// makes UserAgent pick operating systems with probability proportional to weights instead of their
+// approximate market share. Systems missing from weights or with a weight <= 0 are never picked.
+func WithOSWeights(weights map[OperatingSystem]int) UserAgentOption {
+	return func(o *userAgentOptions) {
+		o.systems = weights
+	}
+}
+
+// approximate shares of web traffic
+var (
+	defaultBrowserWeights = map[Browser]int{BrowserChrome: 65, BrowserSafari: 18, BrowserEdge: 9, BrowserFirefox: 8}
+	defaultOSWeights      = map[OperatingSystem]int{OSWindows: 40, OSAndroid: 30, OSIOS: 16, OSMacOS: 10, OSLinux: 4}
+)
+
+// formats the User-Agent of a browser on an operating system for a major version of the browser
+type userAgentFormat struct {
+	os       OperatingSystem
+	browser  Browser
+	versions [2]int
+	format   func(r *randomizer, version int) string
+}
+
+const (
+	chromeTail  = "AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%d.0.0.0"
+	iosPlatform = "Mozilla/5.0 (iPhone; CPU iPhone OS %s like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) "
+)
+
+var userAgentFormats = []userAgentFormat{
+	{OSWindows, BrowserChrome, [2]int{120, 131}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) "+chromeTail+" Safari/537.36", v)
+	}},
+	{OSWindows, BrowserEdge, [2]int{120, 131}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) "+chromeTail+" Safari/537.36 Edg/%d.0.0.0", v, v)
+	}},
+	{OSWindows, BrowserFirefox, [2]int{120, 133}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:%d.0) Gecko/20100101 Firefox/%d.0", v, v)
+	}},
+	{OSMacOS, BrowserChrome, [2]int{120, 131}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) "+chromeTail+" Safari/537.36", v)
+	}},
+	{OSMacOS, BrowserSafari, [2]int{16, 18}, func(r *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%d.%d Safari/605.1.15", v, r.Int(0, 6))
+	}},
+	{OSMacOS, BrowserEdge, [2]int{120, 131}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) "+chromeTail+" Safari/537.36 Edg/%d.0.0.0", v, v)
+	}},
+	{OSMacOS, BrowserFirefox, [2]int{120, 133}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:%d.0) Gecko/20100101 Firefox/%d.0", v, v)
+	}},
+	{OSLinux, BrowserChrome, [2]int{120, 131}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (X11; Linux x86_64) "+chromeTail+" Safari/537.36", v)
+	}},
+	{OSLinux, BrowserFirefox, [2]int{120, 133}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (X11; Linux x86_64; rv:%d.0) Gecko/20100101 Firefox/%d.0", v, v)
+	}},
+	{OSAndroid, BrowserChrome, [2]int{120, 131}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Linux; Android 10; K) "+chromeTail+" Mobile Safari/537.36", v)
+	}},
+	{OSAndroid, BrowserEdge, [2]int{120, 131}, func(_ *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Linux; Android 10; K) "+chromeTail+" Mobile Safari/537.36 EdgA/%d.0.0.0", v, v)
+	}},
+	{OSAndroid, BrowserFirefox, [2]int{120, 133}, func(r *randomizer, v int) string {
+		return fmt.Sprintf("Mozilla/5.0 (Android %d; Mobile; rv:%d.0) Gecko/%d.0 Firefox/%d.0", r.Int(12, 15), v, v, v)
+	}},
+	{OSIOS, BrowserSafari, [2]int{16, 18}, func(r *randomizer, v int) string {
+		minor := r.Int(0, 6)
+		return fmt.Sprintf(iosPlatform+"Version/%d.%d Mobile/15E148 Safari/604.1", fmt.Sprintf("%d_%d", v, minor), v, minor)
+	}},
+	{OSIOS, BrowserChrome, [2]int{120, 131}, func(r *randomizer, v int) string {
+		return fmt.Sprintf(iosPlatform+"CriOS/%d.0.0.0 Mobile/15E148 Safari/604.1", r.iosVersion(), v)
+	}},
+	{OSIOS, BrowserEdge, [2]int{120, 131}, func(r *randomizer, v int) string {
+		return fmt.Sprintf(iosPlatform+"EdgiOS/%d.0.0.0 Mobile/15E148 Safari/605.1.15", r.iosVersion(), v)
+	}},
+	{OSIOS, BrowserFirefox, [2]int{120, 133}, func(r *randomizer, v int) string {
+		return fmt.Sprintf(iosPlatform+"FxiOS/%d.0 Mobile/15E148 Safari/605.1.15", r.iosVersion(), v)
+	}},
+}
+
+// returns realistic User-Agent string of a recent browser release for load tests and anti-bot QA. Browsers
+// and operating systems are weighted by their approximate share of web traffic unless changed with opts, and
+// only existing combinations are generated, e.g. Safari only on macOS and iOS. It panics if opts leave no
+// combination with a positive weight.
+func (r *randomizer) UserAgent(opts ...UserAgentOption) string {
+	o := userAgentOptions{browsers: defaultBrowserWeights, systems: defaultOSWeights}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var candidates []weighted[userAgentFormat]
+	for _, f := range userAgentFormats {
+		if b, s := o.browsers[f.browser], o.systems[f.os]; b > 0 && s > 0 {
+			candidates = append(candidates, weighted[userAgentFormat]{f, b * s})
+		}
+	}
+	if len(candidates) == 0 {
+		panic("invalid argument to UserAgent: no browser and operating system combination with a positive weight")
+	}
+	f := pickWeighted(r, candidates)
+	return f.format(r, r.Int(f.versions[0], f.versions[1]))
+}
+
+// returns iOS version as it appears in User-Agents, e.g. "17_4"
+func (r *randomizer) iosVersion() string {
+	return fmt.Sprintf("%d_%d", r.Int(16, 18), r.Int(0, 6))
+}
@@ -0,0 +1,89 @@
+package random
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+)
+
+// returns random (version 4) UUID in its canonical 36 character form
+func (r *randomizer) UUID() string {
+	return formatUUIDv4(r.Bytes(16))
+}
+
+// returns n random (version 4) UUIDs, drawing the entropy for all of them in a single read
+func (r *randomizer) UUIDs(n int) []string {
+	b := r.Bytes(16 * n)
+	out := make([]string, n)
+	for i := range out {
+		out[i] = formatUUIDv4(b[16*i : 16*i+16])
+	}
+	return out
+}
+
+// returns time-ordered (version 7) UUID of the current millisecond timestamp and 74 random bits. UUIDs of the
+// same millisecond are not ordered among each other, see UUIDv7Generator for that.
+func (r *randomizer) UUIDv7() string {
+	b := make([]byte, 16)
+	putUint48(b, uint64(time.Now().UnixMilli()))
+	copy(b[6:], r.Bytes(10))
+	return formatUUIDv7(b)
+}
+
+// generates time-ordered (version 7) UUIDs that strictly increase, also within the same millisecond, e.g. for
+// database primary keys. Its random bits double as a counter that grows by a random increment per UUID, as
+// allowed by RFC 9562. It is safe for concurrent use.
+type UUIDv7Generator struct {
+	r SFRand
+	m monotonic
+}
+
+// returns UUIDv7Generator drawing from r
+func NewUUIDv7Generator(r SFRand) *UUIDv7Generator {
+	return &UUIDv7Generator{r: r, m: monotonic{hiBits: 10}}
+}
+
+// returns the next UUID, greater than all previously returned ones
+func (g *UUIDv7Generator) Next() string {
+	ms, hi, lo := g.m.next(g.r)
+	b := make([]byte, 16)
+	putUint48(b, ms)
+	// the 74 random bits are split into 12 bits before and 62 bits after the version and variant
+	binary.BigEndian.PutUint16(b[6:], uint16(hi<<2|lo>>62))
+	binary.BigEndian.PutUint64(b[8:], lo)
+	return formatUUIDv7(b)
+}
+
+func formatUUIDv7(b []byte) string {
+	b[6] = (b[6] & 0x0f) | 0x70 // version 7
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+	return formatUUID(b)
+}
+
+// writes the low 48 bits of v to b in big-endian order
+func putUint48(b []byte, v uint64) {
+	for i := 0; i < 6; i++ {
+		b[i] = byte(v >> (40 - 8*i))
+	}
+}
+
+// sets the version and variant bits of b and returns its canonical form
+func formatUUIDv4(b []byte) string {
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+	return formatUUID(b)
+}
+
+func formatUUID(b []byte) string {
+	out := make([]byte, 36)
+	hex.Encode(out[0:8], b[0:4])
+	out[8] = '-'
+	hex.Encode(out[9:13], b[4:6])
+	out[13] = '-'
+	hex.Encode(out[14:18], b[6:8])
+	out[18] = '-'
+	hex.Encode(out[19:23], b[8:10])
+	out[23] = '-'
+	hex.Encode(out[24:], b[10:16])
+	return string(out)
+}
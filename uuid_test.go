@@ -0,0 +1,123 @@
+package random
+
+import (
+	"encoding/hex"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// returns millisecond timestamp of a UUIDv7 and fails t unless it has version 7 and variant 10
+func checkUUIDv7(t *testing.T, id string) uint64 {
+	t.Helper()
+	b, err := hex.DecodeString(strings.ReplaceAll(id, "-", ""))
+	if err != nil || len(id) != 36 || len(b) != 16 {
+		t.Fatalf("%q is not a canonical UUID", id)
+	}
+	if b[6]>>4 != 7 || b[8]>>6 != 2 {
+		t.Fatalf("%q has version %d and variant %b, want 7 and 10", id, b[6]>>4, b[8]>>6)
+	}
+	var ms uint64
+	for _, c := range b[:6] {
+		ms = ms<<8 | uint64(c)
+	}
+	return ms
+}
+
+func TestUUIDv7(t *testing.T) {
+	before := uint64(time.Now().UnixMilli())
+	id := NewSFRand().UUIDv7()
+	after := uint64(time.Now().UnixMilli())
+	if ms := checkUUIDv7(t, id); ms < before || ms > after {
+		t.Errorf("UUIDv7 %q has timestamp %d, want within [%d, %d]", id, ms, before, after)
+	}
+}
+
+func TestUUIDv7GeneratorOrdered(t *testing.T) {
+	g := NewUUIDv7Generator(NewSFRand())
+	prev := g.Next()
+	checkUUIDv7(t, prev)
+	for i := 0; i < 100000; i++ {
+		id := g.Next()
+		if id <= prev {
+			t.Fatalf("UUID %d %q is not greater than the previous %q", i, id, prev)
+		}
+		prev = id
+	}
+	checkUUIDv7(t, prev)
+}
+
+func TestUUIDv7GeneratorConcurrent(t *testing.T) {
+	g := NewUUIDv7Generator(NewSFRand())
+	const goroutines, n = 8, 2000
+	ids := make([][]string, goroutines)
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < n; j++ {
+				ids[i] = append(ids[i], g.Next())
+			}
+		}(i)
+	}
+	wg.Wait()
+	var all []string
+	for _, own := range ids {
+		if !sort.StringsAreSorted(own) {
+			t.Error("UUIDs of a single goroutine are not increasing")
+		}
+		all = append(all, own...)
+	}
+	sort.Strings(all)
+	for i := 1; i < len(all); i++ {
+		if all[i] == all[i-1] {
+			t.Fatalf("UUID %q generated twice", all[i])
+		}
+	}
+}
+
+// the clock going back must not break the order, nor must an overflow of the random part
+func TestMonotonicNext(t *testing.T) {
+	r := NewSFRand()
+	for _, tc := range []struct {
+		name   string
+		hiBits uint
+		ms     uint64
+		hi, lo uint64
+	}{
+		{"clock went back", 10, uint64(time.Now().UnixMilli()) + 60000, 5, 7},
+		{"random part overflows", 10, uint64(time.Now().UnixMilli()) + 60000, 1<<10 - 1, math.MaxUint64},
+		{"16 bit random part overflows", 16, uint64(time.Now().UnixMilli()) + 60000, 1<<16 - 1, math.MaxUint64 - 1},
+	} {
+		m := &monotonic{hiBits: tc.hiBits, ms: tc.ms, hi: tc.hi, lo: tc.lo}
+		ms, hi, lo := m.next(r)
+		if ms < tc.ms || (ms == tc.ms && (hi < tc.hi || hi == tc.hi && lo <= tc.lo)) {
+			t.Errorf("%s: next() = %d, %d, %d, not after %d, %d, %d", tc.name, ms, hi, lo, tc.ms, tc.hi, tc.lo)
+		}
+		if hi >= 1<<tc.hiBits {
+			t.Errorf("%s: next() random part %d exceeds %d bits", tc.name, hi, tc.hiBits)
+		}
+		if tc.hi == 1<<tc.hiBits-1 && ms != tc.ms+1 {
+			t.Errorf("%s: next() = ms %d, want the overflow to borrow ms %d", tc.name, ms, tc.ms+1)
+		}
+	}
+}
+
+func TestUUIDv7GeneratorClockBack(t *testing.T) {
+	g := NewUUIDv7Generator(NewSFRand())
+	g.m.ms = uint64(time.Now().UnixMilli()) + 60000
+	g.m.hi = 1<<10 - 1
+	g.m.lo = math.MaxUint64 - 1<<20
+	prev := g.Next()
+	for i := 0; i < 1000; i++ {
+		id := g.Next()
+		if id <= prev {
+			t.Fatalf("UUID %q is not greater than the previous %q", id, prev)
+		}
+		prev = id
+	}
+}
@@ -0,0 +1,75 @@
+package random
+
+import (
+	"errors"
+	"maps"
+	"sync"
+)
+
+// name of the built-in wordlist, EFF's large wordlist, used by Word, Words and Passphrase
+const DefaultWordlist = "eff-large"
+
+var (
+	// returned by RegisterWordlist for a list without words
+	ErrEmptyWordlist = errors.New("wordlist has no words")
+	// returned by RegisterWordlist for the name of a built-in wordlist such as DefaultWordlist
+	ErrWordlistReserved = errors.New("wordlist name is reserved for a built-in wordlist")
+)
+
+// built-in wordlists, which cannot be replaced so that Word, Words and Passphrase keep their documented entropy
+var builtinWordlists = map[string][]string{DefaultWordlist: effLargeWords}
+
+var (
+	wordlistsMtx sync.RWMutex
+	wordlists    = maps.Clone(builtinWordlists)
+)
+
+// registers a copy of words without duplicates under name, replacing any list of that name, so it can be looked
+// up with Wordlist, e.g. for WithWordlist. Built-in wordlists such as DefaultWordlist cannot be replaced.
+func RegisterWordlist(name string, words []string) error {
+	if len(words) == 0 {
+		return ErrEmptyWordlist
+	}
+	if _, ok := builtinWordlists[name]; ok {
+		return ErrWordlistReserved
+	}
+	wordlistsMtx.Lock()
+	defer wordlistsMtx.Unlock()
+	wordlists[name] = distinctWords(words)
+	return nil
+}
+
+// returns copy of words with all but the first occurrence of each word removed
+func distinctWords(words []string) []string {
+	seen := make(map[string]struct{}, len(words))
+	out := make([]string, 0, len(words))
+	for _, w := range words {
+		if _, ok := seen[w]; !ok {
+			seen[w] = struct{}{}
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+// returns the wordlist registered under name, or nil if there is none. It must not be modified.
+func Wordlist(name string) []string {
+	wordlistsMtx.RLock()
+	defer wordlistsMtx.RUnlock()
+	return wordlists[name]
+}
+
+// returns pseudo-random word from the default wordlist
+func (r *randomizer) Word() string {
+	return Choice(r, Wordlist(DefaultWordlist))
+}
+
+// returns n pseudo-random words from the default wordlist, repeats included
+func (r *randomizer) Words(n int) []string {
+	wordlist := Wordlist(DefaultWordlist)
+	words := make([]string, n)
+	for i := range words {
+		words[i] = Choice(r, wordlist)
+	}
+	return words
+}
@@ -0,0 +1,41 @@
+package random
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRegisterWordlistReserved(t *testing.T) {
+	if err := RegisterWordlist(DefaultWordlist, []string{"a", "b"}); !errors.Is(err, ErrWordlistReserved) {
+		t.Errorf("RegisterWordlist(DefaultWordlist) = %v, want ErrWordlistReserved", err)
+	}
+	if n := len(Wordlist(DefaultWordlist)); n != 7776 {
+		t.Errorf("default wordlist has %d words after failed registration, want 7776", n)
+	}
+	if _, bits := NewSFRand().Passphrase(6, " "); bits < 77 {
+		t.Errorf("Passphrase(6) has %.1f bits of entropy, want about 77.5", bits)
+	}
+}
+
+func TestRegisterWordlist(t *testing.T) {
+	if err := RegisterWordlist("test-empty", nil); !errors.Is(err, ErrEmptyWordlist) {
+		t.Errorf("RegisterWordlist(nil) = %v, want ErrEmptyWordlist", err)
+	}
+	words := []string{"alpha", "beta"}
+	if err := RegisterWordlist("test-greek", words); err != nil {
+		t.Fatal(err)
+	}
+	words[0] = "gamma"
+	if got := Wordlist("test-greek"); len(got) != 2 || got[0] != "alpha" {
+		t.Errorf("Wordlist(test-greek) = %q, want a copy of the registered words", got)
+	}
+}
+
+func TestWordlistDuplicates(t *testing.T) {
+	if err := RegisterWordlist("test-dup", []string{"a", "b", "a", "a", "c", "b"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := Wordlist("test-dup"); len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Errorf("Wordlist(test-dup) = %q, want [a b c]", got)
+	}
+}
@@ -0,0 +1,55 @@
+package random
+
+import "math"
+
+// generates Zipf distributed values, e.g. for skewed workloads with hot keys in load tests. It is the
+// equivalent of math/rand's Zipf driven by an SFRand, using the rejection-inversion method of Hörmann and
+// Derflinger. It is safe for concurrent use if its SFRand is.
+type Zipf struct {
+	r SFRand
+	// parameters s and v of the distribution, and imax
+	q    float64
+	v    float64
+	imax float64
+	// precomputed values of the hat function
+	oneMinusQ    float64
+	oneMinusQInv float64
+	hxm          float64
+	hx0MinusHxm  float64
+	s            float64
+}
+
+// returns Zipf generating values k in [0, imax] with P(k) proportional to (v + k)^(-s). It panics unless
+// s > 1 and v >= 1.
+func NewZipf(r SFRand, s float64, v float64, imax uint64) *Zipf {
+	if !(s > 1) || !(v >= 1) {
+		panic("invalid argument to NewZipf: need s > 1 and v >= 1")
+	}
+	z := &Zipf{r: r, q: s, v: v, imax: float64(imax)}
+	z.oneMinusQ = 1 - s
+	z.oneMinusQInv = 1 / z.oneMinusQ
+	z.hxm = z.h(z.imax + 0.5)
+	z.hx0MinusHxm = z.h(0.5) - math.Exp(math.Log(v)*-s) - z.hxm
+	z.s = 1 - z.hinv(z.h(1.5)-math.Exp(-s*math.Log(v+1)))
+	return z
+}
+
+// returns the next value of the distribution
+func (z *Zipf) Uint64() uint64 {
+	for {
+		ur := z.hxm + z.r.Float64()*z.hx0MinusHxm
+		x := z.hinv(ur)
+		k := math.Floor(x + 0.5)
+		if k-x <= z.s || ur >= z.h(k+0.5)-math.Exp(-math.Log(k+z.v)*z.q) {
+			return uint64(k)
+		}
+	}
+}
+
+func (z *Zipf) h(x float64) float64 {
+	return math.Exp(z.oneMinusQ*math.Log(z.v+x)) * z.oneMinusQInv
+}
+
+func (z *Zipf) hinv(x float64) float64 {
+	return math.Exp(z.oneMinusQInv*math.Log(z.oneMinusQ*x)) - z.v
+}
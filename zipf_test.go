@@ -0,0 +1,58 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNewZipfPanics(t *testing.T) {
+	for _, tc := range []struct{ s, v float64 }{
+		{1, 1},
+		{0.5, 1},
+		{2, 0.99},
+		{math.NaN(), 1},
+		{2, math.NaN()},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewZipf(s=%v, v=%v) did not panic", tc.s, tc.v)
+				}
+			}()
+			NewZipf(NewSFRand(), tc.s, tc.v, 10)
+		}()
+	}
+}
+
+func TestZipfDistribution(t *testing.T) {
+	r := NewSFRand()
+	for _, tc := range []struct {
+		s, v float64
+		imax uint64
+	}{
+		{2, 1, 5},
+		{1.1, 1, 9},
+		{3, 2.5, 4},
+		{1.5, 1, 0},
+	} {
+		z := NewZipf(r, tc.s, tc.v, tc.imax)
+		const trials = 50000
+		counts := make([]int, tc.imax+1)
+		for i := 0; i < trials; i++ {
+			k := z.Uint64()
+			if k > tc.imax {
+				t.Fatalf("Zipf(s=%v, v=%v, imax=%d) returned %d", tc.s, tc.v, tc.imax, k)
+			}
+			counts[k]++
+		}
+		total := 0.0
+		for k := range counts {
+			total += math.Pow(tc.v+float64(k), -tc.s)
+		}
+		for k, c := range counts {
+			p := math.Pow(tc.v+float64(k), -tc.s) / total
+			checkBinomial(t, fmt.Sprintf("Zipf(s=%v, v=%v, imax=%d) = %d", tc.s, tc.v, tc.imax, k), c, trials, p)
+		}
+	}
+}